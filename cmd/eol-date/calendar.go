@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/ui"
+	"github.com/urfave/cli/v3"
+)
+
+func calendarCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "calendar",
+		Usage:     "Export support and EOL dates of products as an iCalendar file",
+		ArgsUsage: "<product...>",
+		Description: `Writes one all-day event for the end of active support and one for the
+end of life of every release cycle. Event UIDs are stable, so re-importing
+or subscribing to a regenerated file updates existing events.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:      "output",
+				Aliases:   []string{"o"},
+				Usage:     "write the calendar to `FILE` instead of stdout",
+				TakesFile: true,
+			},
+		},
+		Action: runCalendar,
+	}
+}
+
+func runCalendar(ctx context.Context, cmd *cli.Command) error {
+	if cmd.NArg() < 1 {
+		return fmt.Errorf("at least one product required\n\nUsage: eol-date calendar <product...>\n\nExample: eol-date calendar python nodejs > eol.ics")
+	}
+	alarmDays := cmd.IntSlice("alarm-days")
+	if err := ui.ValidateAlarmDays(alarmDays); err != nil {
+		return err
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	calendar := make([]ui.ProductCycles, 0, cmd.NArg())
+	for _, query := range cmd.Args().Slice() {
+		product, err := catalog.find(query)
+		if err != nil {
+			return err
+		}
+
+		cycles, err := client.FetchProduct(ctx, product)
+		if err != nil {
+			return fmt.Errorf("failed to fetch product details for %s: %w", product, err)
+		}
+		calendar = append(calendar, ui.ProductCycles{Product: product, Cycles: cycles})
+	}
+
+	clock, err := clockFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := ui.Options{
+		ShowAll:   cmd.Bool("all"),
+		AlarmDays: alarmDays,
+		Clock:     clock,
+	}
+
+	out := os.Stdout
+	if path := cmd.String("output"); path != "" {
+		f, err := os.Create(path) //nolint:gosec // path is provided by the user
+		if err != nil {
+			return fmt.Errorf("failed to create calendar file: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		out = f
+	}
+
+	return ui.WriteCalendar(out, calendar, opts, time.Now(), clock())
+}
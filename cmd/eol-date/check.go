@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oliverandrich/eol-date/internal/check"
+	ver "github.com/oliverandrich/eol-date/internal/version"
+	"github.com/urfave/cli/v3"
+)
+
+func checkCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "check",
+		Usage:     "Check whether a concrete version of a product is still supported",
+		ArgsUsage: "<product> <version>",
+		Description: `Prints a one-line verdict and exits with one of the following codes:
+
+   0  supported and on the latest patch release
+   1  error (e.g. unknown product or network failure)
+   2  supported, but a newer patch release exists
+   3  active support ended, security fixes only
+   4  end of life or of active support within --warn-days
+   5  end of life reached
+   6  the version does not match any release cycle`,
+		Action: runCheck,
+	}
+}
+
+func runCheck(ctx context.Context, cmd *cli.Command) error {
+	if cmd.NArg() < 2 {
+		return fmt.Errorf("product and version required\n\nUsage: eol-date check <product> <version>\n\nExample: eol-date check nodejs 18.20.4")
+	}
+
+	query := cmd.Args().Get(0)
+	installed := cmd.Args().Get(1)
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	product, err := catalog.find(query)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.FetchProduct(ctx, product)
+	if err != nil {
+		return fmt.Errorf("failed to fetch product details: %w", err)
+	}
+
+	cycle, ok := ver.Match(cycles, installed)
+	if !ok {
+		return cli.Exit(fmt.Sprintf("%s %s: no matching release cycle", product, installed), check.ExitUnknownCycle)
+	}
+
+	clock, err := clockFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	result := check.Evaluate(cycle, installed, clock(), cmd.Int("warn-days"))
+	fmt.Println(result.Message(product))
+
+	if code := result.Verdict.ExitCode(); code != check.ExitSupported {
+		return cli.Exit("", code)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/urfave/cli/v3"
+)
+
+// newClient creates the API client configured by the global flags. extra
+// options are applied last and override the flag-based configuration.
+func newClient(cmd *cli.Command, extra ...api.Option) (*api.Client, error) {
+	apiVersion, err := api.ParseAPIVersion(cmd.String("api-version"))
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []api.Option{
+		api.WithBaseURL(cmd.String("api-url")),
+		api.WithUserAgent(fmt.Sprintf("eol-date/%s", version)),
+		api.WithAPIVersion(apiVersion),
+	}
+
+	snap, err := loadSnapshot(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if snap != nil {
+		opts = append(opts, api.WithSnapshot(snap))
+	} else {
+		cache, err := newCache(cmd)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, api.WithCache(cache))
+	}
+
+	return api.NewClient(append(opts, extra...)...), nil
+}
+
+// loadSnapshot loads the snapshot when running in offline mode
+func loadSnapshot(cmd *cli.Command) (*api.Snapshot, error) {
+	path := cmd.String("snapshot")
+	if !cmd.Bool("offline") {
+		if path != "" {
+			return nil, fmt.Errorf("--snapshot is only used together with --offline")
+		}
+		return nil, nil
+	}
+	if path == "" {
+		return nil, fmt.Errorf("--offline requires --snapshot <file>")
+	}
+
+	return api.LoadSnapshot(path)
+}
+
+// newCache creates the API response cache from the command line flags
+func newCache(cmd *cli.Command) (*api.Cache, error) {
+	if cmd.Bool("no-cache") {
+		return nil, nil
+	}
+
+	dir, err := api.DefaultCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := api.NewCache(dir, cmd.Duration("cache-ttl"))
+	cache.Refresh = cmd.Bool("refresh")
+
+	return cache, nil
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// lazyConfig reads the configuration files on first use. Flags are resolved
+// before any action runs, so a broken file must not stop --help, --version
+// or 'config'; the error is reported by the root command's Before instead.
+type lazyConfig struct {
+	cfg  *config.Config
+	err  error
+	once sync.Once
+}
+
+// load returns the configuration, or an empty one and the error if the
+// files cannot be read
+func (l *lazyConfig) load() (*config.Config, error) {
+	l.once.Do(func() {
+		l.cfg, l.err = config.Load()
+		if l.err != nil {
+			l.cfg = &config.Config{}
+		}
+	})
+	return l.cfg, l.err
+}
+
+// fileSource looks up a setting in the configuration files
+type fileSource struct {
+	cfg *lazyConfig
+	key string
+}
+
+func (s fileSource) Lookup() (string, bool) {
+	cfg, _ := s.cfg.load()
+	v, _, ok := cfg.FileValue(s.key)
+	return v, ok
+}
+
+func (s fileSource) String() string {
+	cfg, _ := s.cfg.load()
+	if _, path, ok := cfg.FileValue(s.key); ok {
+		return fmt.Sprintf("config file %q", path)
+	}
+	return "config file"
+}
+
+func (s fileSource) GoString() string {
+	return fmt.Sprintf("&fileSource{key:%q}", s.key)
+}
+
+// settingSources returns the value sources of a setting: the environment
+// variable first, then the project file and the user configuration file.
+// Flags given on the command line take precedence over all of them.
+func settingSources(cfg *lazyConfig, key string) cli.ValueSourceChain {
+	for _, s := range config.Settings {
+		if s.Key == key {
+			return cli.NewValueSourceChain(cli.EnvVar(s.Env), fileSource{cfg: cfg, key: key})
+		}
+	}
+	panic(fmt.Sprintf("unknown setting %q", key))
+}
+
+func configCommand(cfg *lazyConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "Inspect the configuration",
+		Commands: []*cli.Command{
+			{
+				Name:  "show",
+				Usage: "Print the effective configuration and where each value comes from",
+				Description: `Settings are taken from, in order of precedence: command line flags,
+EOL_DATE_* environment variables, the project file (.eol-date.yaml in the
+current directory), the user configuration file ($EOL_DATE_CONFIG or
+$XDG_CONFIG_HOME/eol-date/config.yaml) and the built-in defaults.`,
+				Action: func(_ context.Context, cmd *cli.Command) error {
+					c, err := cfg.load()
+					if err != nil {
+						return err
+					}
+					return showConfig(cmd, c)
+				},
+			},
+		},
+	}
+}
+
+// checkConfig reports a broken configuration file unless cmd, the root
+// command, runs 'config', which must stay usable to inspect it
+func checkConfig(cmd *cli.Command, cfg *lazyConfig) error {
+	if sub := cmd.Command(cmd.Args().First()); sub != nil && sub.Name == "config" {
+		return nil
+	}
+	_, err := cfg.load()
+	return err
+}
+
+// showConfig prints the merged configuration as YAML with the source of
+// each value as a comment
+func showConfig(cmd *cli.Command, cfg *config.Config) error {
+	for _, layer := range []struct {
+		name  string
+		layer config.Layer
+	}{{"user config", cfg.User}, {"project config", cfg.Project}} {
+		status := ""
+		if !layer.layer.Found {
+			status = " (not found)"
+		}
+		fmt.Printf("# %s: %s%s\n", layer.name, layer.layer.Path, status)
+	}
+	if path, err := config.AliasFile(); err == nil {
+		status := ""
+		if _, err := os.Stat(path); err != nil {
+			status = " (not found)"
+		}
+		fmt.Printf("# aliases: %s%s\n", path, status)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, s := range config.Settings {
+		value, source := s.Default, "default"
+		resolved, resolvedFrom, ok := cfg.Resolve(s.Key)
+		if ok {
+			value, source = resolved, resolvedFrom
+		}
+
+		// Flags set on the command line win over every other source
+		if cmd.IsSet(s.Key) {
+			flag := flagValue(cmd, s.Key)
+			if !ok || flag != normalizeValue(s.Key, resolved) {
+				value, source = flag, "command line"
+			}
+		}
+
+		if value == "" {
+			value = `""`
+		}
+		_, _ = fmt.Fprintf(w, "%s: %s\t# %s\n", s.Key, value, source)
+	}
+	return w.Flush()
+}
+
+// flagValue returns the value of a flag in the form used by the config files
+func flagValue(cmd *cli.Command, name string) string {
+	switch v := cmd.Value(name).(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case time.Duration:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// normalizeValue formats a configured value like flagValue, so both can be compared
+func normalizeValue(key, value string) string {
+	switch key {
+	case "all":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return strconv.FormatBool(b)
+		}
+	case "cache-ttl":
+		if d, err := time.ParseDuration(value); err == nil {
+			return d.String()
+		}
+	}
+	return value
+}
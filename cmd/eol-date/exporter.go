@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/exporter"
+	"github.com/urfave/cli/v3"
+)
+
+func exporterCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "exporter",
+		Usage: "Serve EOL data of products as Prometheus/OpenMetrics gauges",
+		Description: `Fetches the release cycles of the given products, refreshes them every
+--interval and serves them on /metrics. Responses are cached as usual, so
+--cache-ttl limits how often the API is actually contacted.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "listen",
+				Usage: "`ADDRESS` to serve metrics on",
+				Value: ":9788",
+			},
+			&cli.StringSliceFlag{
+				Name:     "products",
+				Usage:    "comma-separated `PRODUCTS` to export (e.g. python,nodejs,postgresql)",
+				Required: true,
+			},
+			&cli.DurationFlag{
+				Name:  "interval",
+				Usage: "how often to refresh the data",
+				Value: time.Hour,
+			},
+		},
+		Before: rejectAsOf,
+		Action: runExporter,
+	}
+}
+
+func runExporter(ctx context.Context, cmd *cli.Command) error {
+	interval := cmd.Duration("interval")
+	if err := exporter.ValidateInterval(interval); err != nil {
+		return err
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var products []string
+	for _, query := range cmd.StringSlice("products") {
+		product, err := catalog.find(query)
+		if err != nil {
+			return err
+		}
+		products = append(products, product)
+	}
+
+	exp := exporter.New(client, products)
+	if err := exp.Refresh(ctx); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go exp.Run(ctx, interval, func(err error) {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", exp)
+	srv := &http.Server{
+		Addr:              cmd.String("listen"),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Serving metrics for %d products on %s/metrics\n", len(products), srv.Addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve metrics: %w", err)
+	}
+	return nil
+}
@@ -7,9 +7,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/oliverandrich/eol-date/internal/api"
-	"github.com/oliverandrich/eol-date/internal/search"
 	"github.com/oliverandrich/eol-date/internal/ui"
 	"github.com/urfave/cli/v3"
 )
@@ -17,24 +18,131 @@ import (
 var version = "dev"
 
 func main() {
+	cfg := &lazyConfig{}
+
 	cmd := &cli.Command{
 		Name:      "eol-date",
 		Usage:     "Check end-of-life dates for software products",
-		ArgsUsage: "<product>",
+		ArgsUsage: "<product...>",
 		Version:   version,
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
 				Name:    "all",
 				Aliases: []string{"a"},
 				Usage:   "show all cycles including end-of-life versions",
+				Sources: settingSources(cfg, "all"),
 			},
 			&cli.StringFlag{
 				Name:    "format",
 				Aliases: []string{"f"},
-				Usage:   "output format: table, markdown, csv, html",
+				Usage:   "output format: table, markdown, csv, html, json, ndjson, yaml, toml, ics, openmetrics",
 				Value:   "table",
+				Sources: settingSources(cfg, "format"),
+			},
+			&cli.IntFlag{
+				Name:    "warn-days",
+				Usage:   "treat cycles reaching their end of life or of active support within `DAYS` as expiring",
+				Value:   api.DefaultWarnDays,
+				Sources: settingSources(cfg, "warn-days"),
+			},
+			&cli.StringFlag{
+				Name:  "as-of",
+				Usage: "evaluate support status and relative dates at `DATE` (YYYY-MM-DD) instead of today",
+			},
+			&cli.StringSliceFlag{
+				Name:    "columns",
+				Usage:   "only show the given `COLUMNS` (comma-separated, e.g. cycle,latest,eol)",
+				Sources: settingSources(cfg, "columns"),
+			},
+			&cli.StringFlag{
+				Name:    "theme",
+				Usage:   "color theme: dark, light or none",
+				Value:   "dark",
+				Sources: settingSources(cfg, "theme"),
+			},
+			&cli.StringFlag{
+				Name:      "from-file",
+				Usage:     "read product names from `FILE`, one per line (- for stdin)",
+				TakesFile: true,
+			},
+			&cli.BoolFlag{
+				Name:  "combined",
+				Usage: "show several products in one table with a PRODUCT column",
+			},
+			&cli.BoolFlag{
+				Name:  "no-interactive",
+				Usage: "never prompt to pick a product; implied if stdin or stdout is not a terminal",
+			},
+			&cli.BoolFlag{
+				Name:    "yes",
+				Aliases: []string{"y"},
+				Usage:   "use the best match for a product name without asking",
+			},
+			&cli.IntFlag{
+				Name:  "parallel",
+				Usage: "fetch up to `N` products at the same time",
+				Value: api.DefaultWorkers,
+			},
+			&cli.IntSliceFlag{
+				Name:  "alarm-days",
+				Usage: "remind `DAYS` before each event in calendar output (repeatable)",
+				Value: []int{30},
+			},
+			&cli.StringFlag{
+				Name:    "api-url",
+				Usage:   "base `URL` of the endoflife.date API or a mirror",
+				Value:   api.DefaultBaseURL,
+				Sources: settingSources(cfg, "api-url"),
+			},
+			&cli.StringFlag{
+				Name:  "api-version",
+				Usage: "API flavour to use: legacy or v1",
+				Value: string(api.APILegacy),
+			},
+			&cli.DurationFlag{
+				Name:    "cache-ttl",
+				Usage:   "how long cached API responses stay fresh",
+				Value:   api.DefaultCacheTTL,
+				Sources: settingSources(cfg, "cache-ttl"),
+			},
+			&cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "do not read or write the response cache",
+			},
+			&cli.BoolFlag{
+				Name:  "refresh",
+				Usage: "ignore cached responses and fetch fresh data",
+			},
+			&cli.BoolFlag{
+				Name:  "offline",
+				Usage: "serve all data from the snapshot given by --snapshot",
+			},
+			&cli.StringFlag{
+				Name:      "snapshot",
+				Usage:     "snapshot `FILE` created with 'eol-date snapshot create'",
+				TakesFile: true,
 			},
 		},
+		Before: func(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+			if err := checkConfig(cmd, cfg); err != nil {
+				return ctx, err
+			}
+			return ctx, ui.SetTheme(cmd.String("theme"))
+		},
+		Commands: []*cli.Command{
+			calendarCommand(),
+			checkCommand(),
+			configCommand(cfg),
+			exporterCommand(),
+			mcpCommand(),
+			productsCommand(),
+			sbomCommand(),
+			serveCommand(),
+			scanCommand(),
+			snapshotCommand(),
+			tuiCommand(),
+			verifyCommand(),
+		},
 		Action: run,
 	}
 
@@ -45,39 +153,158 @@ func main() {
 }
 
 func run(ctx context.Context, cmd *cli.Command) error {
-	if cmd.NArg() < 1 {
-		return fmt.Errorf("product name required\n\nUsage: eol-date <product>\n\nExample: eol-date python")
+	queries := cmd.Args().Slice()
+	if path := cmd.String("from-file"); path != "" {
+		names, err := readProductList(path)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, names...)
+	}
+	if len(queries) < 1 {
+		return fmt.Errorf("product name required\n\nUsage: eol-date <product...>\n\nExample: eol-date python")
 	}
 
-	query := cmd.Args().First()
-	showAll := cmd.Bool("all")
 	format := cmd.String("format")
+	if err := ui.ValidateFormat(format); err != nil {
+		return err
+	}
+	columns := cmd.StringSlice("columns")
+	if err := ui.ValidateColumns(columns); err != nil {
+		return err
+	}
+	alarmDays := cmd.IntSlice("alarm-days")
+	if err := ui.ValidateAlarmDays(alarmDays); err != nil {
+		return err
+	}
 
-	products, err := api.FetchProducts(ctx)
+	clock, err := clockFromFlags(cmd)
 	if err != nil {
-		return fmt.Errorf("failed to fetch product list: %w", err)
+		return err
 	}
 
-	product, found := search.FindExact(products, query)
-	if !found {
-		matches := search.FindSimilar(products, query, 10)
-		if len(matches) == 0 {
-			return fmt.Errorf("no products found matching '%s'", query)
-		}
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	opts := ui.Options{
+		Format:    format,
+		ShowAll:   cmd.Bool("all"),
+		Combined:  cmd.Bool("combined"),
+		Columns:   columns,
+		WarnDays:  cmd.Int("warn-days"),
+		AlarmDays: alarmDays,
+		Clock:     clock,
+	}
+	if snap := client.Snapshot(); snap != nil {
+		opts.SnapshotDate = snap.CreatedAt
+	}
 
-		selected, selectErr := ui.SelectProduct(matches)
-		if selectErr != nil {
-			return selectErr
+	yes := cmd.Bool("yes")
+	workers := cmd.Int("parallel")
+	if len(queries) == 1 {
+		var prompt func(string) ([]string, error)
+		if !cmd.Bool("no-interactive") && ui.IsInteractive() {
+			prompt = func(query string) ([]string, error) {
+				fetch := func(product string) ([]api.Cycle, error) {
+					return client.FetchProduct(ctx, product)
+				}
+				return ui.SelectProducts(catalog.products, query, fetch, opts)
+			}
 		}
-		product = selected
+		return runSingle(ctx, client, catalog, queries[0], workers, yes, prompt, opts)
 	}
+	return runMany(ctx, client, catalog, queries, workers, yes, opts)
+}
 
-	cycles, err := api.FetchProduct(ctx, product)
+// runSingle shows one product, letting the user pick one or more products
+// with prompt if the query is neither a product nor an alias
+func runSingle(ctx context.Context, client *api.Client, catalog *productCatalog, query string, workers int, yes bool, prompt func(string) ([]string, error), opts ui.Options) error {
+	products, err := catalog.choose(query, yes, prompt)
+	if err != nil {
+		return err
+	}
+	if len(products) > 1 {
+		return runMany(ctx, client, catalog, products, workers, yes, opts)
+	}
+
+	product := products[0]
+	cycles, err := client.FetchProduct(ctx, product)
 	if err != nil {
 		return fmt.Errorf("failed to fetch product details: %w", err)
 	}
 
-	ui.DisplayCycles(product, cycles, showAll, format)
+	if opts.Combined {
+		return ui.DisplayProducts([]ui.ProductCycles{{Product: product, Cycles: cycles}}, opts)
+	}
+	return ui.DisplayCycles(product, cycles, opts)
+}
 
+// runMany fetches several products concurrently and shows the ones that
+// succeeded before reporting the failures
+func runMany(ctx context.Context, client *api.Client, catalog *productCatalog, queries []string, workers int, yes bool, opts ui.Options) error {
+	var failures []string
+	var names []string
+	for _, query := range queries {
+		product, err := catalog.find(query)
+		if err != nil && yes {
+			var products []string
+			if products, err = catalog.choose(query, true, nil); err == nil {
+				product = products[0]
+			}
+		}
+		if err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+		if !slices.Contains(names, product) {
+			names = append(names, product)
+		}
+	}
+
+	var results []ui.ProductCycles
+	for _, r := range client.FetchProductCycles(ctx, names, workers) {
+		if r.Err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", r.Name, r.Err))
+			continue
+		}
+		results = append(results, ui.ProductCycles{Product: r.Name, Cycles: r.Cycles})
+	}
+
+	if len(results) > 0 {
+		if err := ui.DisplayProducts(results, opts); err != nil {
+			return err
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to fetch %d of %d products:\n  %s",
+			len(failures), len(failures)+len(results), strings.Join(failures, "\n  "))
+	}
 	return nil
 }
+
+// clockFromFlags returns the clock dates are evaluated at: noon of the
+// --as-of date, or the system clock if it is not set
+func clockFromFlags(cmd *cli.Command) (api.Clock, error) {
+	asOf := cmd.String("as-of")
+	if asOf == "" {
+		return api.SystemClock, nil
+	}
+	return api.ParseAsOf(asOf)
+}
+
+// rejectAsOf fails if --as-of is given to a long-running command, which
+// always evaluates dates at the current time
+func rejectAsOf(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+	if cmd.IsSet("as-of") {
+		return ctx, fmt.Errorf("--as-of is not supported by '%s', which always uses the current time", cmd.Name)
+	}
+	return ctx, nil
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/oliverandrich/eol-date/internal/mcp"
+	"github.com/urfave/cli/v3"
+)
+
+func mcpCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "mcp",
+		Usage: "Run a Model Context Protocol server on stdin/stdout",
+		Description: `Speaks newline-delimited JSON-RPC 2.0 on stdin/stdout and offers the
+tools list_products, search_products, get_cycles and check_version.
+
+Example configuration for an MCP client:
+
+   {"mcpServers": {"eol-date": {"command": "eol-date", "args": ["mcp"]}}}`,
+		Before: rejectAsOf,
+		Action: runMCP,
+	}
+}
+
+func runMCP(ctx context.Context, cmd *cli.Command) error {
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	aliases, err := fetchAliases(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	return mcp.NewServer(client, aliases, version).Serve(ctx, os.Stdin, os.Stdout)
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/config"
+	"github.com/oliverandrich/eol-date/internal/search"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	"github.com/urfave/cli/v3"
+)
+
+func productsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "products",
+		Usage: "List available products, optionally filtered by category or tag",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "category",
+				Usage: "only list products in `CATEGORY` (e.g. lang, os, database)",
+			},
+			&cli.StringFlag{
+				Name:  "tag",
+				Usage: "only list products carrying `TAG`",
+			},
+		},
+		Action: listProducts,
+	}
+}
+
+func listProducts(ctx context.Context, cmd *cli.Command) error {
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	category := cmd.String("category")
+	tag := cmd.String("tag")
+	if category != "" && tag != "" {
+		return fmt.Errorf("--category and --tag cannot be combined")
+	}
+
+	// Categories and tags only exist in the v1 API
+	var products []api.ProductSummary
+	switch {
+	case category != "":
+		products, err = client.FetchCategory(ctx, category)
+	case tag != "":
+		products, err = client.FetchTag(ctx, tag)
+	default:
+		products, err = client.FetchProductList(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, p := range products {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, p.Label, p.Category, strings.Join(p.Tags, ","))
+	}
+	return w.Flush()
+}
+
+// productCatalog holds the product names and the aliases queries are resolved with
+type productCatalog struct {
+	products []string
+	aliases  search.Aliases
+}
+
+// fetchCatalog fetches the product list and the aliases queries are
+// resolved with
+func fetchCatalog(ctx context.Context, client *api.Client) (*productCatalog, error) {
+	products, err := client.FetchProducts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product list: %w", err)
+	}
+
+	aliases, err := fetchAliases(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &productCatalog{products: products, aliases: aliases}, nil
+}
+
+// fetchAliases collects the aliases: the built-in ones, those from the v1
+// metadata and the user's alias file, in increasing order of precedence
+func fetchAliases(ctx context.Context, client *api.Client) (search.Aliases, error) {
+	remote, err := client.FetchAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product aliases: %w", err)
+	}
+
+	path, err := config.AliasFile()
+	if err != nil {
+		return nil, err
+	}
+	user, err := config.LoadAliases(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return search.BuiltinAliases.With(remote).With(user), nil
+}
+
+// resolve returns the product named query or one of its aliases
+func (c *productCatalog) resolve(query string) (string, bool) {
+	return search.Resolve(c.products, c.aliases, query)
+}
+
+// choose returns the product named query or one of its aliases. Otherwise
+// the products are picked as decided by ui.DecidePick: with prompt, which
+// is nil in non-interactive sessions, or by taking the best match. It fails
+// with ui.ExitAmbiguous if several candidates remain.
+func (c *productCatalog) choose(query string, yes bool, prompt func(query string) ([]string, error)) ([]string, error) {
+	if product, found := c.resolve(query); found {
+		return []string{product}, nil
+	}
+
+	matches := search.FindSimilar(c.products, query, 10)
+	switch ui.DecidePick(matches, prompt != nil, yes) {
+	case ui.PickPrompt:
+		return prompt(query)
+	case ui.PickTop:
+		fmt.Fprintf(os.Stderr, "Using %s for '%s'\n", matches[0].Product, query)
+		return []string{matches[0].Product}, nil
+	case ui.PickFail:
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no products found matching '%s'", query)
+	}
+	return nil, cli.Exit(fmt.Sprintf("'%s' matches several products:\n  %s\nPass the exact name or use --yes to take the best match",
+		query, strings.Join(search.Names(matches), "\n  ")), ui.ExitAmbiguous)
+}
+
+// find returns the product named query, suggesting similar names if it is
+// neither a product nor an alias
+func (c *productCatalog) find(query string) (string, error) {
+	product, found := c.resolve(query)
+	if !found {
+		msg := fmt.Sprintf("unknown product '%s'", query)
+		if matches := search.Names(search.FindSimilar(c.products, query, 5)); len(matches) > 0 {
+			msg += fmt.Sprintf(" (did you mean: %s?)", strings.Join(matches, ", "))
+		}
+		return "", fmt.Errorf("%s", msg)
+	}
+	return product, nil
+}
+
+// readProductList reads product names from path, one per line. Blank lines
+// and lines starting with # are skipped; "-" reads from stdin.
+func readProductList(path string) ([]string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open product list: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	var names []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read product list: %w", err)
+	}
+	return names, nil
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/check"
+	"github.com/oliverandrich/eol-date/internal/scan"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	ver "github.com/oliverandrich/eol-date/internal/version"
+	"github.com/urfave/cli/v3"
+)
+
+// reportItem is a finding resolved to its release cycle
+type reportItem struct {
+	Entry ui.ReportEntry
+	// Err is set if the product of the finding could not be fetched
+	Err error
+	// Verdict is only meaningful if Resolved is true
+	Verdict  check.Verdict
+	Resolved bool
+}
+
+// resolveFindings maps each finding to an endoflife.date product and release
+// cycle and evaluates its support status at now. Every product is fetched
+// once; a product that cannot be fetched is reported as unknown with the
+// error as status instead of failing the whole report.
+func resolveFindings(ctx context.Context, client *api.Client, findings []scan.Finding, now time.Time, warnDays int) ([]reportItem, error) {
+	if len(findings) == 0 {
+		return nil, nil
+	}
+
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles := make(map[string][]api.Cycle)
+	failed := make(map[string]error)
+
+	items := make([]reportItem, 0, len(findings))
+	for _, f := range findings {
+		item := reportItem{Entry: ui.ReportEntry{Product: f.Product, Version: f.Version, Source: f.Source}}
+
+		product, found := catalog.resolve(f.Product)
+		if !found {
+			item.Entry.Status = "unknown product"
+			item.Entry.Level = ui.LevelUnknown
+			items = append(items, item)
+			continue
+		}
+		item.Entry.Product = product
+
+		if _, ok := cycles[product]; !ok && failed[product] == nil {
+			c, err := client.FetchProduct(ctx, product)
+			if err != nil {
+				failed[product] = err
+			} else {
+				cycles[product] = c
+			}
+		}
+		if err := failed[product]; err != nil {
+			item.Err = err
+			item.Entry.Status = err.Error()
+			item.Entry.Level = ui.LevelUnknown
+			items = append(items, item)
+			continue
+		}
+
+		cycle, ok := ver.Match(cycles[product], f.Version)
+		if !ok {
+			item.Entry.Status = "no matching cycle"
+			item.Entry.Level = ui.LevelUnknown
+			items = append(items, item)
+			continue
+		}
+
+		result := check.Evaluate(cycle, f.Version, now, warnDays)
+		item.Entry.Cycle = cycle
+		item.Entry.Status = result.Verdict.String()
+		item.Entry.Level = reportLevel(result.Verdict)
+		item.Verdict = result.Verdict
+		item.Resolved = true
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// lookupError returns an error with exit code 1 if the product of any
+// finding could not be fetched, so a report missing data never passes as clean
+func lookupError(items []reportItem) error {
+	failed := 0
+	for _, item := range items {
+		if item.Err != nil {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return cli.Exit(fmt.Sprintf("failed to look up %d of %d findings", failed, len(items)), 1)
+}
+
+// reportLevel maps a check verdict to the report level used for coloring
+func reportLevel(v check.Verdict) ui.Level {
+	switch v {
+	case check.Supported:
+		return ui.LevelOK
+	case check.EOL:
+		return ui.LevelEOL
+	case check.OutdatedPatch, check.SecurityOnly, check.EOLSoon:
+		return ui.LevelWarn
+	}
+	return ui.LevelUnknown
+}
+
+// reportOptions returns the display options for a report
+func reportOptions(cmd *cli.Command, client *api.Client) (ui.Options, error) {
+	clock, err := clockFromFlags(cmd)
+	if err != nil {
+		return ui.Options{}, err
+	}
+
+	opts := ui.Options{Format: reportFormat(cmd), Clock: clock}
+	if snap := client.Snapshot(); snap != nil {
+		opts.SnapshotDate = snap.CreatedAt
+	}
+	return opts, nil
+}
+
+// reportFormat returns the --format for a report. A format the reports do not
+// support, e.g. json, falls back to table if it comes from the environment or
+// a config file rather than the command line, so a default meant for product
+// lookups does not break scan, sbom and verify.
+func reportFormat(cmd *cli.Command) string {
+	format := cmd.String("format")
+	if slices.Contains(ui.ReportFormats, format) {
+		return format
+	}
+	for _, f := range cmd.Root().Flags {
+		if sf, ok := f.(*cli.StringFlag); ok && sf.Name == "format" {
+			if configured, found := sf.Sources.Lookup(); found && configured == format {
+				return "table"
+			}
+		}
+	}
+	return format
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/scan"
+	"github.com/urfave/cli/v3"
+)
+
+func TestResolveFindings_FetchFailure(t *testing.T) {
+	t.Setenv("EOL_DATE_CONFIG", t.TempDir()+"/config.yaml")
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/all.json":
+			_, _ = w.Write([]byte(`["nodejs","python"]`))
+		case "/nodejs.json":
+			_, _ = w.Write([]byte(`[{"cycle":"18","latest":"18.20.8","eol":"2025-04-30"}]`))
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+
+	findings := []scan.Finding{
+		{Product: "python", Version: "3.12.4", Source: "a"},
+		{Product: "nodejs", Version: "18.20.8", Source: "b"},
+		{Product: "python", Version: "3.13.1", Source: "c"},
+	}
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	items, err := resolveFindings(context.Background(), api.NewClient(api.WithBaseURL(upstream.URL)), findings, now, api.DefaultWarnDays)
+	if err != nil {
+		t.Fatalf("resolveFindings() error = %v", err)
+	}
+
+	for _, i := range []int{0, 2} {
+		if items[i].Err == nil || items[i].Resolved || items[i].Entry.Status == "" {
+			t.Errorf("items[%d] = %+v, want a fetch failure with the error as status", i, items[i])
+		}
+	}
+	if !items[1].Resolved {
+		t.Errorf("items[1] = %+v, want nodejs resolved", items[1])
+	}
+
+	// The SBOM report keeps the failures next to the EOL component
+	if entries := sbomEntries(items); len(entries) != 3 {
+		t.Errorf("sbomEntries() = %d entries, want 3", len(entries))
+	}
+
+	var exit cli.ExitCoder
+	if err := lookupError(items); !errors.As(err, &exit) || exit.ExitCode() != 1 {
+		t.Errorf("lookupError() = %v, want exit code 1", err)
+	}
+	if err := lookupError(items[1:2]); err != nil {
+		t.Errorf("lookupError() without failures = %v", err)
+	}
+}
+
+func TestReportFormat(t *testing.T) {
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name string
+		env  string
+		args []string
+		want string
+	}{
+		{"default", "", nil, "table"},
+		{"supported format from env", "markdown", nil, "markdown"},
+		{"unsupported format from env falls back", "json", nil, "table"},
+		{"supported format on command line", "json", []string{"--format", "csv"}, "csv"},
+		{"unsupported format on command line is kept", "", []string{"--format", "json"}, "json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("EOL_DATE_FORMAT", tt.env)
+			if tt.env == "" {
+				_ = os.Unsetenv("EOL_DATE_FORMAT")
+			}
+
+			var got string
+			root := &cli.Command{
+				Name: "eol-date",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "format", Value: "table", Sources: cli.EnvVars("EOL_DATE_FORMAT")},
+				},
+				Commands: []*cli.Command{{
+					Name: "scan",
+					Action: func(_ context.Context, cmd *cli.Command) error {
+						got = reportFormat(cmd)
+						return nil
+					},
+				}},
+			}
+			args := append(append([]string{"eol-date"}, tt.args...), "scan")
+			if err := root.Run(context.Background(), args); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("reportFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oliverandrich/eol-date/internal/check"
+	"github.com/oliverandrich/eol-date/internal/sbom"
+	"github.com/oliverandrich/eol-date/internal/scan"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	"github.com/urfave/cli/v3"
+)
+
+func sbomCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "sbom",
+		Usage:     "Report SBOM components that reached or approach their end of life",
+		ArgsUsage: "<file>",
+		Description: `Reads a CycloneDX (JSON or XML) or SPDX (JSON or tag-value) SBOM and maps
+its components to endoflife.date products by their package URL. Operating
+system packages and container images are mapped by name, libraries only if
+they are well-known products like Django, Spring or Angular.`,
+		Action: runSBOM,
+	}
+}
+
+func runSBOM(ctx context.Context, cmd *cli.Command) error {
+	if cmd.NArg() < 1 {
+		return fmt.Errorf("SBOM file required\n\nUsage: eol-date sbom <file>\n\nExample: eol-date sbom bom.cdx.json")
+	}
+	path := cmd.Args().First()
+
+	components, err := sbom.Load(path)
+	if err != nil {
+		return err
+	}
+
+	var findings []scan.Finding
+	for _, c := range components {
+		product, ok := c.Product()
+		if !ok || c.Version == "" {
+			continue
+		}
+		findings = append(findings, scan.Finding{Product: product, Version: c.Version, Source: c.PURL})
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, err := reportOptions(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	items, err := resolveFindings(ctx, client, findings, opts.Clock(), cmd.Int("warn-days"))
+	if err != nil {
+		return err
+	}
+
+	entries := sbomEntries(items)
+	if len(entries) == 0 {
+		fmt.Printf("No components of %s reached or approach their end of life\n", path)
+		return nil
+	}
+
+	if err := ui.DisplayReport(path, entries, opts); err != nil {
+		return err
+	}
+	return lookupError(items)
+}
+
+// sbomEntries returns the actionable report entries: components that reached
+// or approach their end of life and those whose product could not be fetched.
+// Most components are libraries unknown to endoflife.date and are skipped.
+func sbomEntries(items []reportItem) []ui.ReportEntry {
+	var entries []ui.ReportEntry
+	for _, item := range items {
+		if item.Err != nil || (item.Resolved && (item.Verdict == check.EOL || item.Verdict == check.EOLSoon)) {
+			entries = append(entries, item.Entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+
+	"github.com/oliverandrich/eol-date/internal/scan"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	"github.com/urfave/cli/v3"
+)
+
+func scanCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "scan",
+		Usage:     "Report the EOL status of runtimes and images used in a project",
+		ArgsUsage: "[dir]",
+		Description: `Walks the directory (default: the current one) and detects versions in
+go.mod, .nvmrc, .node-version, .python-version, .ruby-version,
+.tool-versions, package.json engines, pyproject.toml, Dockerfiles and
+docker-compose files.`,
+		Action: runScan,
+	}
+}
+
+func runScan(ctx context.Context, cmd *cli.Command) error {
+	dir := "."
+	if cmd.NArg() > 0 {
+		dir = cmd.Args().First()
+	}
+
+	findings, err := scan.Scan(dir)
+	if err != nil {
+		return err
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, err := reportOptions(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	items, err := resolveFindings(ctx, client, findings, opts.Clock(), cmd.Int("warn-days"))
+	if err != nil {
+		return err
+	}
+
+	entries := make([]ui.ReportEntry, len(items))
+	for i, item := range items {
+		entries[i] = item.Entry
+	}
+
+	if err := ui.DisplayReport(dir, entries, opts); err != nil {
+		return err
+	}
+	return lookupError(items)
+}
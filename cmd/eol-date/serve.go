@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/server"
+	"github.com/urfave/cli/v3"
+)
+
+func serveCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "serve",
+		Usage: "Serve an HTML dashboard and a JSON API of the EOL data",
+		Description: `Routes:
+
+   /                         HTML list of all products
+   /products/<product>       HTML table of the release cycles
+   /api/products             JSON list of all products
+   /api/products/<product>   JSON document as produced by --format json
+
+The product routes accept the query parameters all=true (include EOL
+cycles), lts=true (only LTS cycles) and status=active,security-only,eol.
+Upstream responses are kept in memory for --cache-ttl. Failed requests
+are retried after 30 seconds at the earliest; until then, or while the API
+stays unreachable, the last good response is served if there is one.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "listen",
+				Usage: "`ADDRESS` to serve on",
+				Value: "localhost:8080",
+			},
+		},
+		Before: rejectAsOf,
+		Action: runServe,
+	}
+}
+
+func runServe(ctx context.Context, cmd *cli.Command) error {
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	aliases, err := fetchAliases(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:              cmd.String("listen"),
+		Handler:           server.New(client, aliases, cmd.Duration("cache-ttl"), cmd.Int("warn-days")).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Serving on http://%s/\n", srv.Addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/urfave/cli/v3"
+)
+
+func snapshotCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "snapshot",
+		Usage: "Manage offline snapshots of the endoflife.date data",
+		Commands: []*cli.Command{
+			{
+				Name:      "create",
+				Usage:     "Download all products and their cycles into a snapshot file",
+				ArgsUsage: "<file>",
+				Action:    createSnapshot,
+			},
+		},
+	}
+}
+
+func createSnapshot(ctx context.Context, cmd *cli.Command) error {
+	if cmd.NArg() < 1 {
+		return fmt.Errorf("snapshot file required\n\nUsage: eol-date snapshot create <file>")
+	}
+	path := cmd.Args().First()
+
+	// Snapshots always contain freshly downloaded data
+	client, err := newClient(cmd, api.WithCache(nil))
+	if err != nil {
+		return err
+	}
+
+	snap, err := client.CreateSnapshot(ctx, func(done, total int) {
+		fmt.Fprintf(os.Stderr, "\rFetching products... %d/%d", done, total)
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	for _, skipped := range snap.Skipped {
+		fmt.Fprintf(os.Stderr, "warning: skipped %s\n", skipped)
+	}
+
+	if err := snap.Save(path); err != nil {
+		return err
+	}
+
+	fmt.Printf("Snapshot with %d products written to %s\n", len(snap.Responses)-1, path)
+	return nil
+}
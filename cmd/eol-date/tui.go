@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	"github.com/urfave/cli/v3"
+)
+
+func tuiCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "tui",
+		Usage:     "Browse products and release cycles in a full-screen terminal UI",
+		ArgsUsage: "[product]",
+		Description: `Lists all products; enter opens the release cycles of a product and
+then the details of a cycle. The cycles table can be sorted and can
+include cycles that reached their end of life. Press ? for all keys.`,
+		Action: runTUI,
+	}
+}
+
+func runTUI(ctx context.Context, cmd *cli.Command) error {
+	if !ui.IsInteractive() {
+		return fmt.Errorf("the browser needs a terminal\n\nUse eol-date <product> in scripts and pipes")
+	}
+
+	columns := cmd.StringSlice("columns")
+	if err := ui.ValidateColumns(columns); err != nil {
+		return err
+	}
+
+	clock, err := clockFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var product string
+	if cmd.NArg() > 0 {
+		if product, err = catalog.find(cmd.Args().First()); err != nil {
+			return err
+		}
+	}
+
+	opts := ui.Options{
+		ShowAll:  cmd.Bool("all"),
+		Columns:  columns,
+		WarnDays: cmd.Int("warn-days"),
+		Clock:    clock,
+	}
+	fetch := func(name string) ([]api.Cycle, error) {
+		cycles, err := client.FetchProduct(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch product details: %w", err)
+		}
+		return cycles, nil
+	}
+	return ui.Browse(catalog.products, product, fetch, opts)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/check"
+	"github.com/oliverandrich/eol-date/internal/manifest"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	ver "github.com/oliverandrich/eol-date/internal/version"
+	"github.com/urfave/cli/v3"
+)
+
+func verifyCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "verify",
+		Usage:     "Check the versions pinned in a project manifest against its policy",
+		ArgsUsage: "[file]",
+		Description: `Reads the manifest (default: ` + manifest.DefaultFile + `), resolves every
+product to its release cycle and evaluates the policy. Exits with code 1 if
+any entry violates the policy or cannot be resolved.
+
+   policy:
+     warn-days: 180     # report EOL soon within this many days (default 90)
+     fail-on: eol       # outdated, security-only, eol-soon or eol (default)
+     require-lts: false # fail cycles that are not LTS releases
+   products:
+     python: "3.11"
+     postgresql: "15"
+     nodejs:
+       version: "20"
+       require-lts: true`,
+		Action: runVerify,
+	}
+}
+
+func runVerify(ctx context.Context, cmd *cli.Command) error {
+	path := manifest.DefaultFile
+	if cmd.NArg() > 0 {
+		path = cmd.Args().First()
+	}
+
+	m, err := manifest.Load(path)
+	if err != nil {
+		return err
+	}
+	if len(m.Products) == 0 {
+		fmt.Printf("No products listed in %s\n", path)
+		return nil
+	}
+
+	client, err := newClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, err := reportOptions(cmd, client)
+	if err != nil {
+		return err
+	}
+
+	entries, failed, err := verifyManifest(ctx, client, path, m, opts.Clock(), cmd.Int("warn-days"), cmd.Int("parallel"))
+	if err != nil {
+		return err
+	}
+
+	if err := ui.DisplayReport(path, entries, opts); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return cli.Exit(fmt.Sprintf("%d of %d entries violate the policy", failed, len(entries)), 1)
+	}
+	return nil
+}
+
+// verifyManifest evaluates every manifest entry at now and returns the
+// report entries and the number of entries that violate the policy;
+// warnDays applies to entries whose policy sets no window
+func verifyManifest(ctx context.Context, client *api.Client, path string, m *manifest.Manifest, now time.Time, warnDays, workers int) ([]ui.ReportEntry, int, error) {
+	catalog, err := fetchCatalog(ctx, client)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var names []string
+	for _, e := range m.Products {
+		if product, found := catalog.resolve(e.Product); found && !slices.Contains(names, product) {
+			names = append(names, product)
+		}
+	}
+
+	cycles := make(map[string][]api.Cycle, len(names))
+	for _, r := range client.FetchProductCycles(ctx, names, workers) {
+		if r.Err != nil {
+			return nil, 0, fmt.Errorf("failed to fetch product details for %s: %w", r.Name, r.Err)
+		}
+		cycles[r.Name] = r.Cycles
+	}
+
+	failed := 0
+	entries := make([]ui.ReportEntry, 0, len(m.Products))
+	for _, e := range m.Products {
+		entry := ui.ReportEntry{Product: e.Product, Version: e.Version, Source: fmt.Sprintf("%s:%d", path, e.Line)}
+
+		product, found := catalog.resolve(e.Product)
+		if !found {
+			entry.Status = "unknown product"
+			entry.Level = ui.LevelEOL
+			entries = append(entries, entry)
+			failed++
+			continue
+		}
+		entry.Product = product
+
+		cycle, ok := ver.Match(cycles[product], e.Version)
+		if !ok {
+			entry.Status = "no matching cycle"
+			entry.Level = ui.LevelEOL
+			entries = append(entries, entry)
+			failed++
+			continue
+		}
+
+		rules := m.Rules(e, warnDays)
+		result := check.Evaluate(cycle, e.Version, now, rules.WarnDays)
+		entry.Cycle = cycle
+		entry.Status = result.Verdict.String()
+		entry.Level = reportLevel(result.Verdict)
+
+		if violations := rules.Violations(result); len(violations) > 0 {
+			entry.Status = "violates policy: " + strings.Join(violations, ", ")
+			entry.Level = ui.LevelEOL
+			failed++
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, failed, nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultWorkers is the default number of concurrent requests of FetchProductCycles
+const DefaultWorkers = 4
+
+// ProductResult holds the release cycles of one product or the error fetching them
+type ProductResult struct {
+	Err    error
+	Name   string
+	Cycles []Cycle
+}
+
+// FetchProductCycles fetches the release cycles of several products with at
+// most workers concurrent requests. The results are in the order of names;
+// a failing product does not affect the others.
+func (c *Client) FetchProductCycles(ctx context.Context, names []string, workers int) []ProductResult {
+	results := make([]ProductResult, len(names))
+	workers = max(1, min(workers, len(names)))
+
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				cycles, err := c.FetchProduct(ctx, names[i])
+				results[i] = ProductResult{Name: names[i], Cycles: cycles, Err: err}
+			}
+		}()
+	}
+
+	for i := range names {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
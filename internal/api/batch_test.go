@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_FetchProductCycles(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/python.json": `[{"cycle":"3.13"}]`,
+		"/nodejs.json": `[{"cycle":"22"},{"cycle":"20"}]`,
+		"/go.json":     `[{"cycle":"1.23"}]`,
+	})
+	client := NewClient(WithBaseURL(srv.URL))
+
+	results := client.FetchProductCycles(context.Background(), []string{"python", "cobol", "nodejs", "go"}, 2)
+
+	if len(results) != 4 {
+		t.Fatalf("FetchProductCycles() returned %d results, want 4", len(results))
+	}
+	wantCycles := map[string]int{"python": 1, "nodejs": 2, "go": 1}
+	for i, name := range []string{"python", "cobol", "nodejs", "go"} {
+		r := results[i]
+		if r.Name != name {
+			t.Errorf("results[%d].Name = %q, want %q", i, r.Name, name)
+		}
+		if name == "cobol" {
+			if r.Err == nil {
+				t.Error("FetchProductCycles() returned no error for unknown product")
+			}
+			continue
+		}
+		if r.Err != nil || len(r.Cycles) != wantCycles[name] {
+			t.Errorf("results[%d] = %+v, want %d cycles", i, r, wantCycles[name])
+		}
+	}
+}
+
+func TestClient_FetchProductCycles_BoundedConcurrency(t *testing.T) {
+	var active, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient(WithBaseURL(srv.URL))
+
+	names := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	for _, r := range client.FetchProductCycles(context.Background(), names, 3) {
+		if r.Err != nil {
+			t.Fatalf("FetchProductCycles() error = %v", r.Err)
+		}
+	}
+
+	if p := peak.Load(); p > 3 || p < 2 {
+		t.Errorf("peak concurrency = %d, want between 2 and 3", p)
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached API responses are considered fresh
+const DefaultCacheTTL = 24 * time.Hour
+
+// Cache stores API responses on disk, one file per request URL
+type Cache struct {
+	// Now returns the current time; tests replace it to control expiry
+	Now Clock
+	// Dir is the directory holding the cache entries
+	Dir string
+	// TTL is the maximum age of an entry before it is refetched
+	TTL time.Duration
+	// Refresh ignores existing entries, even as a fallback for failed
+	// requests, but still stores new responses
+	Refresh bool
+}
+
+// cacheEntry is the on-disk representation of a cached response
+type cacheEntry struct {
+	FetchedAt time.Time       `json:"fetchedAt"`
+	URL       string          `json:"url"`
+	Body      json.RawMessage `json:"body"`
+}
+
+// NewCache creates a cache in dir with the given TTL
+func NewCache(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		Now: SystemClock,
+		Dir: dir,
+		TTL: ttl,
+	}
+}
+
+// DefaultCacheDir returns the eol-date directory below the user cache dir
+// ($XDG_CACHE_HOME or ~/.cache on Linux)
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine cache directory: %w", err)
+	}
+	return filepath.Join(dir, "eol-date"), nil
+}
+
+// path returns the file name of the entry for url
+func (c *Cache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// load reads the entry for url regardless of its age
+func (c *Cache) load(url string) (*cacheEntry, bool) {
+	data, err := os.ReadFile(c.path(url))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if json.Unmarshal(data, &entry) != nil || entry.URL != url {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// Get returns the cached body for url if present and younger than the TTL
+func (c *Cache) Get(url string) ([]byte, bool) {
+	if c.Refresh {
+		return nil, false
+	}
+
+	entry, ok := c.load(url)
+	if !ok {
+		return nil, false
+	}
+	if c.Now().Sub(entry.FetchedAt) > c.TTL {
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// Stale returns the cached body for url even if it has expired
+func (c *Cache) Stale(url string) ([]byte, bool) {
+	if c.Refresh {
+		return nil, false
+	}
+
+	entry, ok := c.load(url)
+	if !ok {
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// Put stores body as the entry for url
+func (c *Cache) Put(url string, body []byte) error {
+	if !json.Valid(body) {
+		return errors.New("refusing to cache invalid JSON")
+	}
+
+	data, err := json.Marshal(cacheEntry{
+		FetchedAt: c.Now(),
+		URL:       url,
+		Body:      body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(c.Dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Write to a temporary file first so concurrent readers never see partial entries
+	tmp, err := os.CreateTemp(c.Dir, "entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache entry: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(url)); err != nil {
+		return fmt.Errorf("failed to store cache entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestCache returns a cache in a temp dir whose clock is controlled by *now
+func newTestCache(t *testing.T, now *time.Time) *Cache {
+	t.Helper()
+	c := NewCache(t.TempDir(), time.Hour)
+	c.Now = func() time.Time { return *now }
+	return c
+}
+
+func TestCache_PutGet(t *testing.T) {
+	now := time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC)
+	c := newTestCache(t, &now)
+
+	const url = "https://example.com/all.json"
+	if _, ok := c.Get(url); ok {
+		t.Fatal("Get() on empty cache returned an entry")
+	}
+
+	if err := c.Put(url, []byte(`["python"]`)); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	body, ok := c.Get(url)
+	if !ok {
+		t.Fatal("Get() after Put() returned no entry")
+	}
+	if string(body) != `["python"]` {
+		t.Errorf("Get() = %s, want %s", body, `["python"]`)
+	}
+
+	if _, ok := c.Get("https://example.com/other.json"); ok {
+		t.Error("Get() returned an entry for a different URL")
+	}
+}
+
+func TestCache_Expiry(t *testing.T) {
+	now := time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC)
+	c := newTestCache(t, &now)
+
+	const url = "https://example.com/python.json"
+	if err := c.Put(url, []byte(`[]`)); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	now = now.Add(59 * time.Minute)
+	if _, ok := c.Get(url); !ok {
+		t.Error("Get() within TTL returned no entry")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get(url); ok {
+		t.Error("Get() after TTL returned an entry")
+	}
+	if _, ok := c.Stale(url); !ok {
+		t.Error("Stale() after TTL returned no entry")
+	}
+}
+
+func TestCache_Refresh(t *testing.T) {
+	now := time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC)
+	c := newTestCache(t, &now)
+	c.Refresh = true
+
+	const url = "https://example.com/python.json"
+	if err := c.Put(url, []byte(`[]`)); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if _, ok := c.Get(url); ok {
+		t.Error("Get() with Refresh returned an entry")
+	}
+	if _, ok := c.Stale(url); ok {
+		t.Error("Stale() with Refresh returned an entry")
+	}
+}
+
+func TestCache_InvalidData(t *testing.T) {
+	now := time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC)
+	c := newTestCache(t, &now)
+
+	const url = "https://example.com/python.json"
+	if err := c.Put(url, []byte(`not json`)); err == nil {
+		t.Error("Put() with invalid JSON returned no error")
+	}
+
+	if err := os.WriteFile(c.path(url), []byte("garbage"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.Get(url); ok {
+		t.Error("Get() returned an entry for a corrupt file")
+	}
+}
+
+func TestClient_FetchProductsFromCache(t *testing.T) {
+	now := time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC)
+	c := newTestCache(t, &now)
+	if err := c.Put(DefaultBaseURL+"/all.json", []byte(`["go","python"]`)); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	client := NewClient(WithCache(c))
+
+	products, err := client.FetchProducts(context.Background())
+	if err != nil {
+		t.Fatalf("FetchProducts() error = %v", err)
+	}
+	if want := []string{"go", "python"}; !reflect.DeepEqual(products, want) {
+		t.Errorf("FetchProducts() = %v, want %v", products, want)
+	}
+}
@@ -7,35 +7,207 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
-const baseURL = "https://endoflife.date/api"
+const (
+	// DefaultBaseURL is the public endoflife.date API
+	DefaultBaseURL = "https://endoflife.date/api"
+	// DefaultTimeout is the request timeout of the default HTTP client
+	DefaultTimeout = 10 * time.Second
+	// DefaultUserAgent is sent with every request unless overridden
+	DefaultUserAgent = "eol-date"
+)
 
-var httpClient = &http.Client{
-	Timeout: 10 * time.Second,
+// Client fetches data from an endoflife.date compatible API
+type Client struct {
+	httpClient *http.Client
+	cache      *Cache
+	snapshot   *Snapshot
+	baseURL    string
+	userAgent  string
+	apiVersion APIVersion
+	timeout    time.Duration
 }
 
-// FetchProducts retrieves the list of all product names from endoflife.date
-func FetchProducts(ctx context.Context) ([]string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/all.json", nil)
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL points the client at another API, e.g. an internal mirror
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithHTTPClient replaces the HTTP client used for requests. Its timeout is
+// kept unless WithTimeout is given; nil keeps the default client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithTimeout sets the timeout for each request, overriding the one of the
+// HTTP client
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// WithAPIVersion selects the API endpoints used by FetchProducts and FetchProduct
+func WithAPIVersion(v APIVersion) Option {
+	return func(c *Client) {
+		c.apiVersion = v
+	}
+}
+
+// WithCache enables the on-disk response cache
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithSnapshot serves all requests from s without any network access. The
+// snapshot's API version takes precedence over WithAPIVersion.
+func WithSnapshot(s *Snapshot) Option {
+	return func(c *Client) {
+		c.snapshot = s
+	}
+}
+
+// NewClient creates a client for the public API, adjusted by opts
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:    DefaultBaseURL,
+		userAgent:  DefaultUserAgent,
+		apiVersion: APILegacy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.snapshot != nil && c.snapshot.APIVersion != "" {
+		c.apiVersion = c.snapshot.APIVersion
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	if c.timeout > 0 && c.httpClient.Timeout != c.timeout {
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
+	}
+
+	return c
+}
+
+// APIVersion returns the API flavour used by FetchProducts and FetchProduct
+func (c *Client) APIVersion() APIVersion {
+	return c.apiVersion
+}
+
+// Snapshot returns the snapshot the client serves in offline mode, if any
+func (c *Client) Snapshot() *Snapshot {
+	return c.snapshot
+}
+
+// defaultClient is used by the package-level fetch functions
+var defaultClient = NewClient()
+
+// SetDefaultClient replaces the client used by the package-level fetch functions
+func SetDefaultClient(c *Client) {
+	defaultClient = c
+}
+
+// fetch returns the status code and body for path below the base URL. In
+// offline mode it is served from the snapshot; otherwise fresh responses come
+// from the cache and a stale entry is used when the request fails.
+func (c *Client) fetch(ctx context.Context, path string) (int, []byte, error) {
+	if c.snapshot != nil {
+		if body, ok := c.snapshot.Responses[path]; ok {
+			return http.StatusOK, body, nil
+		}
+		return http.StatusNotFound, nil, nil
+	}
+
+	url := c.baseURL + "/" + path
+
+	if c.cache != nil {
+		if body, ok := c.cache.Get(url); ok {
+			return http.StatusOK, body, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/json")
 
-	resp, err := httpClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch products: %w", err)
+		if c.cache != nil {
+			if body, ok := c.cache.Stale(url); ok {
+				return http.StatusOK, body, nil
+			}
+		}
+		return 0, nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusOK && c.cache != nil {
+		// A failing cache write must not break the lookup itself
+		_ = c.cache.Put(url, body)
+	}
+
+	return resp.StatusCode, body, nil
+}
+
+// FetchProducts retrieves the list of all product names
+func (c *Client) FetchProducts(ctx context.Context) ([]string, error) {
+	if c.apiVersion == APIV1 {
+		list, err := c.FetchProductList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		products := make([]string, len(list))
+		for i, p := range list {
+			products[i] = p.Name
+		}
+		return products, nil
+	}
+
+	status, body, err := c.fetch(ctx, "all.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch products: %w", err)
+	}
+
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", status)
 	}
 
 	var products []string
-	if err := json.NewDecoder(resp.Body).Decode(&products); err != nil {
+	if err := json.Unmarshal(body, &products); err != nil {
 		return nil, fmt.Errorf("failed to decode products: %w", err)
 	}
 
@@ -43,30 +215,46 @@ func FetchProducts(ctx context.Context) ([]string, error) {
 }
 
 // FetchProduct retrieves the release cycles for a specific product
-func FetchProduct(ctx context.Context, name string) ([]Cycle, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s.json", baseURL, name), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+func (c *Client) FetchProduct(ctx context.Context, name string) ([]Cycle, error) {
+	if c.apiVersion == APIV1 {
+		details, err := c.FetchProductDetails(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		cycles := make([]Cycle, len(details.Releases))
+		for i := range details.Releases {
+			cycles[i] = details.Releases[i].Cycle()
+		}
+		return cycles, nil
 	}
 
-	resp, err := httpClient.Do(req)
+	status, body, err := c.fetch(ctx, name+".json")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch product %s: %w", name, err)
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode == http.StatusNotFound {
+	if status == http.StatusNotFound {
 		return nil, fmt.Errorf("product %s not found", name)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d for product %s", resp.StatusCode, name)
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d for product %s", status, name)
 	}
 
 	var cycles []Cycle
-	if err := json.NewDecoder(resp.Body).Decode(&cycles); err != nil {
+	if err := json.Unmarshal(body, &cycles); err != nil {
 		return nil, fmt.Errorf("failed to decode cycles for %s: %w", name, err)
 	}
 
 	return cycles, nil
 }
+
+// FetchProducts retrieves the list of all product names using the default client
+func FetchProducts(ctx context.Context) ([]string, error) {
+	return defaultClient.FetchProducts(ctx)
+}
+
+// FetchProduct retrieves the release cycles for a product using the default client
+func FetchProduct(ctx context.Context, name string) ([]Cycle, error) {
+	return defaultClient.FetchProduct(ctx, name)
+}
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestServer serves the given bodies keyed by request path; unknown paths return 404
+func newTestServer(t *testing.T, responses map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClient_FetchProducts(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/api/all.json": `["go","nodejs","python"]`,
+	})
+	client := NewClient(WithBaseURL(srv.URL + "/api/"))
+
+	products, err := client.FetchProducts(context.Background())
+	if err != nil {
+		t.Fatalf("FetchProducts() error = %v", err)
+	}
+	if want := []string{"go", "nodejs", "python"}; !reflect.DeepEqual(products, want) {
+		t.Errorf("FetchProducts() = %v, want %v", products, want)
+	}
+}
+
+func TestClient_FetchProduct(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/python.json": `[{"cycle":"3.13","latest":"3.13.11","eol":"2029-10-31","lts":false}]`,
+		"/broken.json": `{"cycle":`,
+	})
+	client := NewClient(WithBaseURL(srv.URL))
+	ctx := context.Background()
+
+	cycles, err := client.FetchProduct(ctx, "python")
+	if err != nil {
+		t.Fatalf("FetchProduct() error = %v", err)
+	}
+	if len(cycles) != 1 || cycles[0].Cycle != "3.13" || cycles[0].Latest != "3.13.11" {
+		t.Errorf("FetchProduct() = %+v, want cycle 3.13", cycles)
+	}
+
+	_, err = client.FetchProduct(ctx, "unknown")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("FetchProduct() unknown product error = %v, want not found", err)
+	}
+
+	if _, err := client.FetchProduct(ctx, "broken"); err == nil {
+		t.Error("FetchProduct() with invalid JSON returned no error")
+	}
+}
+
+func TestClient_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient(WithBaseURL(srv.URL))
+
+	_, err := client.FetchProducts(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "status 500") {
+		t.Errorf("FetchProducts() error = %v, want status 500", err)
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient(WithBaseURL(srv.URL), WithUserAgent("eol-date/test"))
+	if _, err := client.FetchProducts(context.Background()); err != nil {
+		t.Fatalf("FetchProducts() error = %v", err)
+	}
+	if got != "eol-date/test" {
+		t.Errorf("User-Agent = %q, want %q", got, "eol-date/test")
+	}
+}
+
+func TestClient_Timeout(t *testing.T) {
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name string
+		opts []Option
+		want time.Duration
+	}{
+		{"default client", nil, DefaultTimeout},
+		{"own client keeps its timeout", []Option{WithHTTPClient(&http.Client{Timeout: time.Minute})}, time.Minute},
+		{"own client without timeout", []Option{WithHTTPClient(&http.Client{})}, 0},
+		{"WithTimeout overrides", []Option{WithHTTPClient(&http.Client{Timeout: time.Minute}), WithTimeout(time.Second)}, time.Second},
+		{"nil client", []Option{WithHTTPClient(nil)}, DefaultTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewClient(tt.opts...).httpClient.Timeout; got != tt.want {
+				t.Errorf("Timeout = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_TimeoutKeepsProvidedClient(t *testing.T) {
+	hc := &http.Client{}
+	NewClient(WithHTTPClient(hc), WithTimeout(time.Second))
+
+	if hc.Timeout != 0 {
+		t.Error("WithTimeout() modified the provided HTTP client")
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`["python"]`))
+	}))
+	t.Cleanup(srv.Close)
+
+	now := time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC)
+	client := NewClient(WithBaseURL(srv.URL), WithCache(newTestCache(t, &now)))
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := client.FetchProducts(ctx); err != nil {
+			t.Fatalf("FetchProducts() error = %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests, want 1", requests)
+	}
+
+	// An expired entry is still used when the server is unreachable
+	now = now.Add(2 * time.Hour)
+	srv.Close()
+	products, err := client.FetchProducts(ctx)
+	if err != nil {
+		t.Fatalf("FetchProducts() with stale cache error = %v", err)
+	}
+	if want := []string{"python"}; !reflect.DeepEqual(products, want) {
+		t.Errorf("FetchProducts() = %v, want %v", products, want)
+	}
+
+	// --refresh asks for fresh data, so the failure is not hidden
+	client.cache.Refresh = true
+	if _, err := client.FetchProducts(ctx); err == nil {
+		t.Error("FetchProducts() with Refresh served the stale cache entry")
+	}
+}
+
+func TestClient_CreateSnapshot(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/all.json":    `["go","python"]`,
+		"/go.json":     `[{"cycle":"1.25"}]`,
+		"/python.json": `[{"cycle":"3.13"}]`,
+	})
+	client := NewClient(WithBaseURL(srv.URL))
+
+	calls := 0
+	snap, err := client.CreateSnapshot(context.Background(), func(_, total int) {
+		calls++
+		if total != 2 {
+			t.Errorf("progress total = %d, want 2", total)
+		}
+	})
+	if err != nil {
+		t.Fatalf("CreateSnapshot() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("progress called %d times, want 2", calls)
+	}
+	for _, path := range []string{"all.json", "go.json", "python.json"} {
+		if _, ok := snap.Responses[path]; !ok {
+			t.Errorf("snapshot is missing %s", path)
+		}
+	}
+}
+
+func TestClient_CreateSnapshotFailure(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/all.json":    `["go","python"]`,
+		"/python.json": `[{"cycle":"3.13"}]`,
+	})
+	client := NewClient(WithBaseURL(srv.URL))
+
+	// A failing product is skipped, the others are kept
+	snap, err := client.CreateSnapshot(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("CreateSnapshot() error = %v", err)
+	}
+	if _, ok := snap.Responses["python.json"]; !ok {
+		t.Error("snapshot is missing python.json")
+	}
+	if _, ok := snap.Responses["go.json"]; ok {
+		t.Error("snapshot contains the failed go.json")
+	}
+	if want := []string{"go: API returned status 404"}; !reflect.DeepEqual(snap.Skipped, want) {
+		t.Errorf("Skipped = %v, want %v", snap.Skipped, want)
+	}
+
+	// Without any product the snapshot is useless
+	srv = newTestServer(t, map[string]string{"/all.json": `["go","python"]`})
+	client = NewClient(WithBaseURL(srv.URL))
+	_, err = client.CreateSnapshot(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "go: API returned status 404") {
+		t.Errorf("CreateSnapshot() error = %v, want failure for go", err)
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"fmt"
+	"time"
+)
+
+// Clock returns the current time. All date calculations take the time from
+// a clock, so they can be evaluated at another date and tested
+// deterministically.
+type Clock func() time.Time
+
+// SystemClock returns the wall clock time
+func SystemClock() time.Time {
+	return time.Now()
+}
+
+// FixedClock returns a clock that always reports t
+func FixedClock(t time.Time) Clock {
+	return func() time.Time { return t }
+}
+
+// ParseAsOf returns a clock fixed at noon UTC of the date s (YYYY-MM-DD),
+// so cycles ending on that day count as ended
+func ParseAsOf(s string) (Clock, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date '%s' (expected YYYY-MM-DD)", s)
+	}
+	return FixedClock(t.Add(12 * time.Hour)), nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAsOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "date", input: "2027-01-01", want: time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{name: "date and time", input: "2027-01-01T10:00:00Z", wantErr: true},
+		{name: "invalid", input: "tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, err := ParseAsOf(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAsOf(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err == nil && !clock().Equal(tt.want) {
+				t.Errorf("ParseAsOf(%q)() = %v, want %v", tt.input, clock(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAsOf_EndOfLifeDay(t *testing.T) {
+	clock, err := ParseAsOf("2027-01-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eol := EOLValue{DateValue: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if !eol.IsEOLAt(clock()) {
+		t.Error("cycle ending on the --as-of date is not EOL")
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// snapshotWorkers limits the number of concurrent requests while creating a snapshot
+const snapshotWorkers = 8
+
+// Snapshot is a bundle of API responses that allows fully offline lookups
+type Snapshot struct {
+	// CreatedAt is the time the responses were downloaded
+	CreatedAt time.Time `json:"createdAt"`
+	// Responses maps the request path relative to the API base URL to its body
+	Responses map[string]json.RawMessage `json:"responses"`
+	// APIVersion is the API flavour the responses were fetched with
+	APIVersion APIVersion `json:"apiVersion,omitempty"`
+	// Skipped lists the products that could not be fetched, with the reason
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// snapshotPaths returns the path of the product list and a function building
+// the path of a single product for the given API version
+func snapshotPaths(v APIVersion) (string, func(name string) string) {
+	if v == APIV1 {
+		return "v1/products", func(name string) string { return "v1/products/" + name }
+	}
+	return "all.json", func(name string) string { return name + ".json" }
+}
+
+// CreateSnapshot downloads the product list and the cycles of every product.
+// Products that cannot be fetched are left out and listed in Skipped; only if
+// none can be fetched an error is returned. progress, if not nil, is called
+// after each product has been fetched.
+func (c *Client) CreateSnapshot(ctx context.Context, progress func(done, total int)) (*Snapshot, error) {
+	if c.snapshot != nil {
+		return nil, errors.New("cannot create a snapshot in offline mode")
+	}
+
+	createdAt := time.Now()
+	listPath, productPath := snapshotPaths(c.apiVersion)
+
+	status, body, err := c.fetch(ctx, listPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch products: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", status)
+	}
+
+	products, err := productNames(c.apiVersion, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode products: %w", err)
+	}
+
+	snap := &Snapshot{
+		CreatedAt:  createdAt,
+		Responses:  map[string]json.RawMessage{listPath: body},
+		APIVersion: c.apiVersion,
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		failures []string
+		done     int
+	)
+
+	names := make(chan string)
+	for range snapshotWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range names {
+				path := productPath(name)
+				status, body, err := c.fetch(ctx, path)
+
+				mu.Lock()
+				switch {
+				case err != nil:
+					failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+				case status != http.StatusOK:
+					failures = append(failures, fmt.Sprintf("%s: API returned status %d", name, status))
+				default:
+					snap.Responses[path] = body
+				}
+				done++
+				if progress != nil {
+					progress(done, len(products))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, name := range products {
+		names <- name
+	}
+	close(names)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(failures)
+	if len(products) > 0 && len(failures) == len(products) {
+		return nil, fmt.Errorf("failed to fetch %d products:\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	snap.Skipped = failures
+
+	return snap, nil
+}
+
+// productNames extracts the product names from a product list response
+func productNames(v APIVersion, body []byte) ([]string, error) {
+	if v != APIV1 {
+		var names []string
+		err := json.Unmarshal(body, &names)
+		return names, err
+	}
+
+	var env v1Envelope[[]ProductSummary]
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, err
+	}
+	names := make([]string, len(env.Result))
+	for i, p := range env.Result {
+		names[i] = p.Name
+	}
+	return names, nil
+}
+
+// Save writes the snapshot as gzip-compressed JSON to path
+func (s *Snapshot) Save(path string) error {
+	f, err := os.Create(path) //nolint:gosec // path is provided by the user
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+
+	zw := gzip.NewWriter(f)
+	if err := json.NewEncoder(zw).Encode(s); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads a snapshot written by Save
+func LoadSnapshot(path string) (*Snapshot, error) {
+	f, err := os.Open(path) //nolint:gosec // path is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+	defer func() { _ = zr.Close() }()
+
+	var snap Snapshot
+	if err := json.NewDecoder(zr).Decode(&snap); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", path, err)
+	}
+	if listPath, _ := snapshotPaths(snap.APIVersion); snap.Responses[listPath] == nil {
+		return nil, fmt.Errorf("snapshot %s contains no product list", path)
+	}
+
+	return &snap, nil
+}
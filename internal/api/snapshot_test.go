@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testSnapshot() *Snapshot {
+	return &Snapshot{
+		CreatedAt: time.Date(2025, 10, 7, 12, 0, 0, 0, time.UTC),
+		Responses: map[string]json.RawMessage{
+			"all.json":    json.RawMessage(`["go","python"]`),
+			"python.json": json.RawMessage(`[{"cycle":"3.13","latest":"3.13.11","eol":"2029-10-31"}]`),
+		},
+	}
+}
+
+func TestSnapshot_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json.gz")
+
+	want := testSnapshot()
+	if err := want.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, want.CreatedAt)
+	}
+	if len(got.Responses) != len(want.Responses) {
+		t.Errorf("len(Responses) = %d, want %d", len(got.Responses), len(want.Responses))
+	}
+}
+
+func TestLoadSnapshot_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := LoadSnapshot(filepath.Join(dir, "missing.json.gz")); err == nil {
+		t.Error("LoadSnapshot() of missing file returned no error")
+	}
+
+	plain := filepath.Join(dir, "plain.json")
+	if err := os.WriteFile(plain, []byte(`{}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSnapshot(plain); err == nil {
+		t.Error("LoadSnapshot() of uncompressed file returned no error")
+	}
+
+	empty := filepath.Join(dir, "empty.json.gz")
+	if err := (&Snapshot{}).Save(empty); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSnapshot(empty); err == nil {
+		t.Error("LoadSnapshot() of snapshot without product list returned no error")
+	}
+}
+
+func TestClient_Offline(t *testing.T) {
+	client := NewClient(WithSnapshot(testSnapshot()))
+	ctx := context.Background()
+
+	products, err := client.FetchProducts(ctx)
+	if err != nil {
+		t.Fatalf("FetchProducts() error = %v", err)
+	}
+	if want := []string{"go", "python"}; !reflect.DeepEqual(products, want) {
+		t.Errorf("FetchProducts() = %v, want %v", products, want)
+	}
+
+	cycles, err := client.FetchProduct(ctx, "python")
+	if err != nil {
+		t.Fatalf("FetchProduct() error = %v", err)
+	}
+	if len(cycles) != 1 || cycles[0].Cycle != "3.13" {
+		t.Errorf("FetchProduct() = %+v, want cycle 3.13", cycles)
+	}
+
+	if _, err := client.FetchProduct(ctx, "go"); err == nil {
+		t.Error("FetchProduct() for product missing from snapshot returned no error")
+	}
+
+	if _, err := client.CreateSnapshot(ctx, nil); err == nil {
+		t.Error("CreateSnapshot() in offline mode returned no error")
+	}
+}
@@ -5,6 +5,7 @@ package api //nolint:revive // package name is intentional
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -14,9 +15,14 @@ type Cycle struct {
 	LatestReleaseDate Date     `json:"latestReleaseDate"`
 	EOL               EOLValue `json:"eol"`
 	Support           EOLValue `json:"support"`
+	ExtendedSupport   EOLValue `json:"extendedSupport"`
+	Discontinued      EOLValue `json:"discontinued"`
 	LTS               LTSValue `json:"lts"`
 	Cycle             string   `json:"cycle"`
 	Latest            string   `json:"latest"`
+	Codename          string   `json:"codename"`
+	ReleaseLabel      string   `json:"releaseLabel"`
+	Link              string   `json:"link"`
 }
 
 // Date handles date parsing from the API (YYYY-MM-DD format)
@@ -43,6 +49,14 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON writes the date as "YYYY-MM-DD", or null if it is unset
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format("2006-01-02"))
+}
+
 // EOLValue can be a boolean (false = still supported, true = EOL) or a date string
 type EOLValue struct {
 	DateValue time.Time
@@ -74,12 +88,23 @@ func (e *EOLValue) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// IsEOL returns true if the product has reached end of life
-func (e *EOLValue) IsEOL() bool {
+// MarshalJSON writes the value in its upstream form: a boolean, a
+// "YYYY-MM-DD" date, or null if the upstream data contained no value
+func (e EOLValue) MarshalJSON() ([]byte, error) {
+	return marshalDateOrBool(e.IsBoolean, e.BoolValue, e.DateValue)
+}
+
+// IsSet returns true if the upstream data contained a value
+func (e *EOLValue) IsSet() bool {
+	return e.IsBoolean || !e.DateValue.IsZero()
+}
+
+// IsEOLAt returns true if the product has reached end of life at t
+func (e *EOLValue) IsEOLAt(t time.Time) bool {
 	if e.IsBoolean {
 		return e.BoolValue
 	}
-	return !e.DateValue.IsZero() && time.Now().After(e.DateValue)
+	return !e.DateValue.IsZero() && t.After(e.DateValue)
 }
 
 // String returns a string representation of the EOL value
@@ -127,6 +152,22 @@ func (l *LTSValue) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON writes the value in its upstream form like EOLValue.MarshalJSON
+func (l LTSValue) MarshalJSON() ([]byte, error) {
+	return marshalDateOrBool(l.IsBoolean, l.BoolValue, l.DateValue)
+}
+
+// marshalDateOrBool encodes a value that is either a boolean or a date
+func marshalDateOrBool(isBoolean, b bool, t time.Time) ([]byte, error) {
+	if isBoolean {
+		return json.Marshal(b)
+	}
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format("2006-01-02"))
+}
+
 // IsLTS returns true if this is an LTS release
 func (l *LTSValue) IsLTS() bool {
 	if l.IsBoolean {
@@ -148,3 +189,84 @@ func (l *LTSValue) String() string {
 	}
 	return l.DateValue.Format("2006-01-02")
 }
+
+// DefaultWarnDays is the default window in days for StatusExpiring
+const DefaultWarnDays = 90
+
+// Status classifies a release cycle at a point in time. The values are
+// ordered by severity.
+type Status int
+
+const (
+	// StatusActive means the cycle receives full support
+	StatusActive Status = iota
+	// StatusSecurityOnly means active support ended and only security fixes are provided
+	StatusSecurityOnly
+	// StatusExpiring means the end of life or of active support is within the warning window
+	StatusExpiring
+	// StatusEOL means the cycle reached its end of life
+	StatusEOL
+)
+
+// String returns the status as used in the json output
+func (s Status) String() string {
+	switch s {
+	case StatusActive:
+		return "active"
+	case StatusSecurityOnly:
+		return "security-only"
+	case StatusExpiring:
+		return "expiring"
+	case StatusEOL:
+		return "eol"
+	}
+	return "unknown"
+}
+
+// DaysUntil returns the number of calendar days from now until t in UTC,
+// negative once t passed. A date is at most warnDays away in StatusAt
+// exactly if DaysUntil returns at most warnDays.
+func DaysUntil(now, t time.Time) int {
+	now, t = now.UTC(), t.UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// StatusAt classifies the cycle at now. Cycles whose end of life or end of
+// active support is at most warnDays away are expiring.
+func (c *Cycle) StatusAt(now time.Time, warnDays int) Status {
+	if c.EOL.IsEOLAt(now) {
+		return StatusEOL
+	}
+
+	window := now.AddDate(0, 0, warnDays)
+	eolDate := !c.EOL.IsBoolean && !c.EOL.DateValue.IsZero()
+	supportDate := !c.Support.IsBoolean && !c.Support.DateValue.IsZero()
+	supportEnded := supportDate && now.After(c.Support.DateValue)
+
+	switch {
+	case eolDate && !window.Before(c.EOL.DateValue):
+		return StatusExpiring
+	case supportEnded:
+		return StatusSecurityOnly
+	case supportDate && !window.Before(c.Support.DateValue):
+		return StatusExpiring
+	}
+	return StatusActive
+}
+
+// MarshalText encodes the status as its name, e.g. "security-only"
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ParseStatus returns the status named s
+func ParseStatus(s string) (Status, error) {
+	for st := StatusActive; st <= StatusEOL; st++ {
+		if st.String() == s {
+			return st, nil
+		}
+	}
+	return StatusActive, fmt.Errorf("invalid status '%s' (valid: active, security-only, expiring, eol)", s)
+}
@@ -112,8 +112,8 @@ func TestEOLValue_UnmarshalJSON(t *testing.T) {
 	}
 }
 
-func TestEOLValue_IsEOL(t *testing.T) {
-	now := time.Now()
+func TestEOLValue_IsEOLAt(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name string
@@ -145,17 +145,65 @@ func TestEOLValue_IsEOL(t *testing.T) {
 			eol:  EOLValue{IsBoolean: false, DateValue: time.Time{}},
 			want: false,
 		},
+		{
+			name: "ends today",
+			eol:  EOLValue{IsBoolean: false, DateValue: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)},
+			want: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.eol.IsEOL(); got != tt.want {
-				t.Errorf("EOLValue.IsEOL() = %v, want %v", got, tt.want)
+			if got := tt.eol.IsEOLAt(now); got != tt.want {
+				t.Errorf("EOLValue.IsEOLAt() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestCycle_StatusAt(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	date := func(days int) EOLValue { return EOLValue{DateValue: now.AddDate(0, 0, days)} }
+
+	tests := []struct {
+		name     string
+		cycle    Cycle
+		warnDays int
+		want     Status
+	}{
+		{name: "active", cycle: Cycle{Support: date(400), EOL: date(800)}, warnDays: 90, want: StatusActive},
+		{name: "no dates", cycle: Cycle{EOL: EOLValue{IsBoolean: true}}, warnDays: 90, want: StatusActive},
+		{name: "support ends in window", cycle: Cycle{Support: date(30), EOL: date(800)}, warnDays: 90, want: StatusExpiring},
+		{name: "security only", cycle: Cycle{Support: date(-30), EOL: date(400)}, warnDays: 90, want: StatusSecurityOnly},
+		{name: "EOL in window", cycle: Cycle{Support: date(-30), EOL: date(60)}, warnDays: 90, want: StatusExpiring},
+		{name: "EOL on last day of window", cycle: Cycle{EOL: date(90)}, warnDays: 90, want: StatusExpiring},
+		{name: "EOL outside window", cycle: Cycle{EOL: date(91)}, warnDays: 90, want: StatusActive},
+		{name: "no window", cycle: Cycle{Support: date(1), EOL: date(2)}, warnDays: 0, want: StatusActive},
+		{name: "EOL date passed", cycle: Cycle{EOL: date(-1)}, warnDays: 90, want: StatusEOL},
+		{name: "EOL flag", cycle: Cycle{EOL: EOLValue{IsBoolean: true, BoolValue: true}}, warnDays: 90, want: StatusEOL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cycle.StatusAt(now, tt.warnDays); got != tt.want {
+				t.Errorf("StatusAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for st := StatusActive; st <= StatusEOL; st++ {
+		got, err := ParseStatus(st.String())
+		if err != nil || got != st {
+			t.Errorf("ParseStatus(%q) = %v, %v, want %v", st.String(), got, err, st)
+		}
+	}
+	if _, err := ParseStatus("retired"); err == nil {
+		t.Error("ParseStatus() accepted unknown status")
+	}
+}
+
 func TestEOLValue_String(t *testing.T) {
 	tests := []struct {
 		name string
@@ -310,3 +358,133 @@ func TestCycle_UnmarshalJSON(t *testing.T) {
 		t.Error("Cycle.LTS.IsLTS() = true, want false")
 	}
 }
+
+func TestCycle_UnmarshalJSONOptionalFields(t *testing.T) {
+	jsonData := `{
+		"cycle": "11-24h2-e",
+		"releaseLabel": "11 24H2 (E)",
+		"codename": "Hudson Valley",
+		"releaseDate": "2024-10-01",
+		"eol": "2027-10-12",
+		"support": "2027-10-12",
+		"extendedSupport": "2030-10-08",
+		"discontinued": true,
+		"lts": false,
+		"latest": "10.0.26100",
+		"link": "https://learn.microsoft.com/windows/release-health/"
+	}`
+
+	var cycle Cycle
+	if err := json.Unmarshal([]byte(jsonData), &cycle); err != nil {
+		t.Fatalf("Cycle.UnmarshalJSON() error = %v", err)
+	}
+
+	if cycle.ReleaseLabel != "11 24H2 (E)" {
+		t.Errorf("Cycle.ReleaseLabel = %q, want %q", cycle.ReleaseLabel, "11 24H2 (E)")
+	}
+	if cycle.Codename != "Hudson Valley" {
+		t.Errorf("Cycle.Codename = %q, want %q", cycle.Codename, "Hudson Valley")
+	}
+	if cycle.Link != "https://learn.microsoft.com/windows/release-health/" {
+		t.Errorf("Cycle.Link = %q", cycle.Link)
+	}
+	if cycle.ExtendedSupport.IsBoolean || cycle.ExtendedSupport.DateValue.Format("2006-01-02") != "2030-10-08" {
+		t.Errorf("Cycle.ExtendedSupport = %v, want date 2030-10-08", cycle.ExtendedSupport)
+	}
+	if !cycle.Discontinued.IsBoolean || !cycle.Discontinued.BoolValue {
+		t.Errorf("Cycle.Discontinued = %v, want true", cycle.Discontinued)
+	}
+}
+
+func TestCycle_UnmarshalJSONMissingOptionalFields(t *testing.T) {
+	var cycle Cycle
+	if err := json.Unmarshal([]byte(`{"cycle": "3.13", "codename": null, "link": null}`), &cycle); err != nil {
+		t.Fatalf("Cycle.UnmarshalJSON() error = %v", err)
+	}
+
+	if cycle.Codename != "" || cycle.Link != "" || cycle.ReleaseLabel != "" {
+		t.Errorf("Cycle = %+v, want empty optional strings", cycle)
+	}
+	if cycle.ExtendedSupport.IsSet() {
+		t.Error("Cycle.ExtendedSupport.IsSet() = true, want false")
+	}
+	if cycle.Discontinued.IsSet() {
+		t.Error("Cycle.Discontinued.IsSet() = true, want false")
+	}
+}
+
+func TestEOLValue_IsSet(t *testing.T) {
+	tests := []struct {
+		name string
+		eol  EOLValue
+		want bool
+	}{
+		{name: "boolean true", eol: EOLValue{IsBoolean: true, BoolValue: true}, want: true},
+		{name: "boolean false", eol: EOLValue{IsBoolean: true, BoolValue: false}, want: true},
+		{name: "date", eol: EOLValue{DateValue: time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)}, want: true},
+		{name: "zero", eol: EOLValue{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.eol.IsSet(); got != tt.want {
+				t.Errorf("EOLValue.IsSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycle_MarshalJSON(t *testing.T) {
+	jsonData := `{"cycle":"3.13","releaseDate":"2024-10-07","eol":"2029-10-31","support":true,` +
+		`"discontinued":false,"lts":"2024-10-29","latest":"3.13.11"}`
+	want := `{"releaseDate":"2024-10-07","latestReleaseDate":null,"eol":"2029-10-31","support":true,` +
+		`"extendedSupport":null,"discontinued":false,"lts":"2024-10-29","cycle":"3.13","latest":"3.13.11",` +
+		`"codename":"","releaseLabel":"","link":""}`
+
+	var cycle Cycle
+	if err := json.Unmarshal([]byte(jsonData), &cycle); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	got, err := json.Marshal(cycle)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want int
+	}{
+		{"same day", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), 0},
+		{"tomorrow", time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC), 1},
+		{"window end", time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC), 90},
+		{"yesterday", time.Date(2026, 1, 14, 0, 0, 0, 0, time.UTC), -1},
+		{"other time zone", time.Date(2026, 1, 16, 0, 30, 0, 0, time.FixedZone("CET", 3600)), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysUntil(now, tt.t); got != tt.want {
+				t.Errorf("DaysUntil() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	// The window of StatusAt ends on the same day
+	c := Cycle{EOL: EOLValue{DateValue: time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC)}}
+	if got := c.StatusAt(now, 90); got != StatusExpiring {
+		t.Errorf("StatusAt() = %v at DaysUntil() = 90, want expiring", got)
+	}
+	c.EOL.DateValue = c.EOL.DateValue.AddDate(0, 0, 1)
+	if got := c.StatusAt(now, 90); got != StatusActive {
+		t.Errorf("StatusAt() = %v at DaysUntil() = 91, want active", got)
+	}
+}
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// APIVersion selects the endoflife.date API flavour used by a Client
+type APIVersion string
+
+const (
+	// APILegacy uses the original /api/<product>.json endpoints
+	APILegacy APIVersion = "legacy"
+	// APIV1 uses the /api/v1 endpoints with the richer product schema
+	APIV1 APIVersion = "v1"
+)
+
+// ParseAPIVersion validates an API version given on the command line
+func ParseAPIVersion(s string) (APIVersion, error) {
+	switch v := APIVersion(s); v {
+	case APILegacy, APIV1:
+		return v, nil
+	default:
+		return "", fmt.Errorf("unknown API version %q (valid: %s, %s)", s, APILegacy, APIV1)
+	}
+}
+
+// ProductSummary is a product entry in the v1 product, category and tag listings
+type ProductSummary struct {
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Category string   `json:"category"`
+	URI      string   `json:"uri"`
+	Aliases  []string `json:"aliases"`
+	Tags     []string `json:"tags"`
+}
+
+// ProductDetails is the full v1 description of a product and its releases
+type ProductDetails struct {
+	Links          ProductLinks  `json:"links"`
+	Labels         ProductLabels `json:"labels"`
+	Name           string        `json:"name"`
+	Label          string        `json:"label"`
+	Category       string        `json:"category"`
+	VersionCommand string        `json:"versionCommand"`
+	Aliases        []string      `json:"aliases"`
+	Tags           []string      `json:"tags"`
+	Identifiers    []Identifier  `json:"identifiers"`
+	Releases       []Release     `json:"releases"`
+}
+
+// Identifier is an external identifier of a product, e.g. a purl or CPE
+type Identifier struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// ProductLabels holds the product specific names of the lifecycle phases
+type ProductLabels struct {
+	EOAS         string `json:"eoas"`
+	EOL          string `json:"eol"`
+	EOES         string `json:"eoes"`
+	Discontinued string `json:"discontinued"`
+}
+
+// ProductLinks holds links related to a product
+type ProductLinks struct {
+	Icon          string `json:"icon"`
+	HTML          string `json:"html"`
+	ReleasePolicy string `json:"releasePolicy"`
+}
+
+// Release is a release cycle in the v1 schema. Phase flags are nil when the
+// product has no such phase.
+type Release struct {
+	ReleaseDate      Date           `json:"releaseDate"`
+	LTSFrom          Date           `json:"ltsFrom"`
+	EOASFrom         Date           `json:"eoasFrom"`
+	EOLFrom          Date           `json:"eolFrom"`
+	EOESFrom         Date           `json:"eoesFrom"`
+	DiscontinuedFrom Date           `json:"discontinuedFrom"`
+	Latest           *LatestRelease `json:"latest"`
+	IsEOAS           *bool          `json:"isEoas"`
+	IsEOES           *bool          `json:"isEoes"`
+	IsDiscontinued   *bool          `json:"isDiscontinued"`
+	Custom           map[string]any `json:"custom"`
+	Name             string         `json:"name"`
+	Codename         string         `json:"codename"`
+	Label            string         `json:"label"`
+	IsLTS            bool           `json:"isLts"`
+	IsEOL            bool           `json:"isEol"`
+	IsMaintained     bool           `json:"isMaintained"`
+}
+
+// LatestRelease is the most recent patch release of a release cycle
+type LatestRelease struct {
+	Date Date   `json:"date"`
+	Name string `json:"name"`
+	Link string `json:"link"`
+}
+
+// v1Envelope wraps every v1 response
+type v1Envelope[T any] struct {
+	Result T `json:"result"`
+}
+
+// Cycle maps the release onto the legacy cycle representation
+func (r *Release) Cycle() Cycle {
+	c := Cycle{
+		Cycle:       r.Name,
+		ReleaseDate: r.ReleaseDate,
+		EOL:         phaseValue(r.EOLFrom, &r.IsEOL),
+		LTS:         LTSValue{IsBoolean: true, BoolValue: r.IsLTS},
+	}
+
+	if !r.LTSFrom.IsZero() {
+		c.LTS = LTSValue{DateValue: r.LTSFrom.Time}
+	}
+
+	// The legacy schema reports whether active support is ongoing, v1 whether it ended
+	if r.IsEOAS != nil {
+		ongoing := !*r.IsEOAS
+		c.Support = phaseValue(r.EOASFrom, &ongoing)
+	}
+
+	// Extended support follows the same inverted semantics as active support
+	if r.IsEOES != nil {
+		ongoing := !*r.IsEOES
+		c.ExtendedSupport = phaseValue(r.EOESFrom, &ongoing)
+	}
+
+	c.Discontinued = phaseValue(r.DiscontinuedFrom, r.IsDiscontinued)
+	c.Codename = r.Codename
+
+	// The v1 label defaults to the cycle name; only keep meaningful labels
+	if r.Label != r.Name {
+		c.ReleaseLabel = r.Label
+	}
+
+	if r.Latest != nil {
+		c.Latest = r.Latest.Name
+		c.LatestReleaseDate = r.Latest.Date
+		c.Link = r.Latest.Link
+	}
+
+	return c
+}
+
+// phaseValue prefers the phase date and falls back to its boolean flag
+func phaseValue(from Date, flag *bool) EOLValue {
+	if !from.IsZero() {
+		return EOLValue{DateValue: from.Time}
+	}
+	if flag == nil {
+		return EOLValue{}
+	}
+	return EOLValue{IsBoolean: true, BoolValue: *flag}
+}
+
+// fetchV1 decodes the result of the v1 endpoint at path into v. what names
+// the requested resource in error messages.
+func (c *Client) fetchV1(ctx context.Context, path, what string, v any) error {
+	status, body, err := c.fetch(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", what, err)
+	}
+
+	if status == http.StatusNotFound {
+		return fmt.Errorf("%s not found", what)
+	}
+
+	if status != http.StatusOK {
+		return fmt.Errorf("API returned status %d for %s", status, what)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", what, err)
+	}
+
+	return nil
+}
+
+// FetchProductList retrieves all products with their v1 metadata
+func (c *Client) FetchProductList(ctx context.Context) ([]ProductSummary, error) {
+	var env v1Envelope[[]ProductSummary]
+	if err := c.fetchV1(ctx, "v1/products", "products", &env); err != nil {
+		return nil, err
+	}
+	return env.Result, nil
+}
+
+// FetchAliases returns the alternative names of products from the v1
+// metadata, mapped to the product names. The legacy API has no aliases, so
+// the map is empty unless the client uses the v1 API.
+func (c *Client) FetchAliases(ctx context.Context) (map[string]string, error) {
+	aliases := make(map[string]string)
+	if c.apiVersion != APIV1 {
+		return aliases, nil
+	}
+
+	list, err := c.FetchProductList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range list {
+		for _, a := range p.Aliases {
+			aliases[a] = p.Name
+		}
+	}
+	return aliases, nil
+}
+
+// FetchProductDetails retrieves the full v1 description of a product
+func (c *Client) FetchProductDetails(ctx context.Context, name string) (*ProductDetails, error) {
+	var env v1Envelope[ProductDetails]
+	if err := c.fetchV1(ctx, "v1/products/"+url.PathEscape(name), "product "+name, &env); err != nil {
+		return nil, err
+	}
+	return &env.Result, nil
+}
+
+// FetchCategory retrieves the products belonging to a category, e.g. "lang"
+func (c *Client) FetchCategory(ctx context.Context, category string) ([]ProductSummary, error) {
+	var env v1Envelope[[]ProductSummary]
+	if err := c.fetchV1(ctx, "v1/categories/"+url.PathEscape(category), "category "+category, &env); err != nil {
+		return nil, err
+	}
+	return env.Result, nil
+}
+
+// FetchTag retrieves the products carrying a tag, e.g. "microsoft"
+func (c *Client) FetchTag(ctx context.Context, tag string) ([]ProductSummary, error) {
+	var env v1Envelope[[]ProductSummary]
+	if err := c.fetchV1(ctx, "v1/tags/"+url.PathEscape(tag), "tag "+tag, &env); err != nil {
+		return nil, err
+	}
+	return env.Result, nil
+}
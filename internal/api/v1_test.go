@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package api //nolint:revive // package name is intentional
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const v1ProductList = `{
+	"schema_version": "1.2.0",
+	"total": 2,
+	"result": [
+		{"name": "go", "label": "Go", "aliases": ["golang"], "category": "lang", "tags": ["google", "lang"], "uri": "https://endoflife.date/api/v1/products/go"},
+		{"name": "python", "label": "Python", "aliases": [], "category": "lang", "tags": ["lang"], "uri": "https://endoflife.date/api/v1/products/python"}
+	]
+}`
+
+const v1Python = `{
+	"schema_version": "1.2.0",
+	"result": {
+		"name": "python",
+		"label": "Python",
+		"aliases": ["py"],
+		"category": "lang",
+		"tags": ["lang"],
+		"versionCommand": "python --version",
+		"identifiers": [{"id": "pkg:generic/python", "type": "purl"}],
+		"labels": {"eoas": "Active Support", "eol": "Security Support", "eoes": null, "discontinued": null},
+		"links": {"icon": "https://simpleicons.org/icons/python.svg", "html": "https://endoflife.date/python", "releasePolicy": null},
+		"releases": [
+			{
+				"name": "3.13", "codename": null, "label": "3.13",
+				"releaseDate": "2024-10-07",
+				"isLts": false, "ltsFrom": null,
+				"isEoas": false, "eoasFrom": "2026-10-01",
+				"isEoes": null, "eoesFrom": null,
+				"isDiscontinued": null, "discontinuedFrom": null,
+				"isEol": false, "eolFrom": "2029-10-31",
+				"isMaintained": true,
+				"latest": {"name": "3.13.11", "date": "2025-01-14", "link": "https://www.python.org/downloads/release/python-31311/"}
+			},
+			{
+				"name": "2.7", "codename": null, "label": "2.7",
+				"releaseDate": "2010-07-03",
+				"isLts": false, "ltsFrom": null,
+				"isEoas": true, "eoasFrom": null,
+				"isEol": true, "eolFrom": null,
+				"isMaintained": false,
+				"latest": null
+			}
+		]
+	}
+}`
+
+func TestParseAPIVersion(t *testing.T) {
+	for _, s := range []string{"legacy", "v1"} {
+		if v, err := ParseAPIVersion(s); err != nil || string(v) != s {
+			t.Errorf("ParseAPIVersion(%q) = %q, %v", s, v, err)
+		}
+	}
+	if _, err := ParseAPIVersion("v2"); err == nil {
+		t.Error("ParseAPIVersion(\"v2\") returned no error")
+	}
+}
+
+func TestRelease_Cycle(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name    string
+		release Release
+		want    Cycle
+	}{
+		{
+			name: "dates",
+			release: Release{
+				Name:        "3.13",
+				ReleaseDate: Date{time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)},
+				EOASFrom:    Date{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+				IsEOAS:      &no,
+				EOLFrom:     Date{time.Date(2029, 10, 31, 0, 0, 0, 0, time.UTC)},
+				LTSFrom:     Date{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+				IsLTS:       true,
+				Latest:      &LatestRelease{Name: "3.13.11", Date: Date{time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)}},
+			},
+			want: Cycle{
+				Cycle:             "3.13",
+				Latest:            "3.13.11",
+				ReleaseDate:       Date{time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)},
+				LatestReleaseDate: Date{time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)},
+				Support:           EOLValue{DateValue: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+				EOL:               EOLValue{DateValue: time.Date(2029, 10, 31, 0, 0, 0, 0, time.UTC)},
+				LTS:               LTSValue{DateValue: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "flags only",
+			release: Release{Name: "2.7", Label: "2.7", IsEOAS: &yes, IsEOL: true},
+			want: Cycle{
+				Cycle:   "2.7",
+				Support: EOLValue{IsBoolean: true, BoolValue: false},
+				EOL:     EOLValue{IsBoolean: true, BoolValue: true},
+				LTS:     LTSValue{IsBoolean: true, BoolValue: false},
+			},
+		},
+		{
+			name: "optional fields",
+			release: Release{
+				Name:           "24.04",
+				Codename:       "Noble Numbat",
+				Label:          "24.04 LTS",
+				IsEOES:         &no,
+				EOESFrom:       Date{time.Date(2036, 4, 30, 0, 0, 0, 0, time.UTC)},
+				IsDiscontinued: &yes,
+				Latest:         &LatestRelease{Name: "24.04.3", Link: "https://wiki.ubuntu.com/NobleNumbat/ReleaseNotes"},
+			},
+			want: Cycle{
+				Cycle:           "24.04",
+				Codename:        "Noble Numbat",
+				ReleaseLabel:    "24.04 LTS",
+				Latest:          "24.04.3",
+				Link:            "https://wiki.ubuntu.com/NobleNumbat/ReleaseNotes",
+				ExtendedSupport: EOLValue{DateValue: time.Date(2036, 4, 30, 0, 0, 0, 0, time.UTC)},
+				Discontinued:    EOLValue{IsBoolean: true, BoolValue: true},
+				EOL:             EOLValue{IsBoolean: true, BoolValue: false},
+				LTS:             LTSValue{IsBoolean: true, BoolValue: false},
+			},
+		},
+		{
+			name:    "no active support phase",
+			release: Release{Name: "1.0"},
+			want: Cycle{
+				Cycle: "1.0",
+				EOL:   EOLValue{IsBoolean: true, BoolValue: false},
+				LTS:   LTSValue{IsBoolean: true, BoolValue: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.release.Cycle(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Release.Cycle() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_V1(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/v1/products":        v1ProductList,
+		"/v1/products/python": v1Python,
+		"/v1/categories/lang": v1ProductList,
+		"/v1/tags/google":     `{"result": [{"name": "go", "label": "Go", "category": "lang", "tags": ["google", "lang"]}]}`,
+	})
+	client := NewClient(WithBaseURL(srv.URL), WithAPIVersion(APIV1))
+	ctx := context.Background()
+
+	products, err := client.FetchProducts(ctx)
+	if err != nil {
+		t.Fatalf("FetchProducts() error = %v", err)
+	}
+	if want := []string{"go", "python"}; !reflect.DeepEqual(products, want) {
+		t.Errorf("FetchProducts() = %v, want %v", products, want)
+	}
+
+	aliases, err := client.FetchAliases(ctx)
+	if err != nil {
+		t.Fatalf("FetchAliases() error = %v", err)
+	}
+	if want := map[string]string{"golang": "go"}; !reflect.DeepEqual(aliases, want) {
+		t.Errorf("FetchAliases() = %v, want %v", aliases, want)
+	}
+
+	cycles, err := client.FetchProduct(ctx, "python")
+	if err != nil {
+		t.Fatalf("FetchProduct() error = %v", err)
+	}
+	if len(cycles) != 2 {
+		t.Fatalf("FetchProduct() returned %d cycles, want 2", len(cycles))
+	}
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	if cycles[0].Latest != "3.13.11" || cycles[0].EOL.IsEOLAt(now) {
+		t.Errorf("cycle 3.13 = %+v, want latest 3.13.11 and not EOL", cycles[0])
+	}
+	if !cycles[1].EOL.IsEOLAt(now) {
+		t.Error("cycle 2.7 is not EOL")
+	}
+
+	details, err := client.FetchProductDetails(ctx, "python")
+	if err != nil {
+		t.Fatalf("FetchProductDetails() error = %v", err)
+	}
+	if details.Label != "Python" || details.Links.HTML != "https://endoflife.date/python" {
+		t.Errorf("FetchProductDetails() = %+v", details)
+	}
+	if len(details.Identifiers) != 1 || details.Identifiers[0].Type != "purl" {
+		t.Errorf("Identifiers = %+v, want one purl", details.Identifiers)
+	}
+
+	_, err = client.FetchProduct(ctx, "unknown")
+	if err == nil || !strings.Contains(err.Error(), "product unknown not found") {
+		t.Errorf("FetchProduct() unknown product error = %v, want not found", err)
+	}
+
+	category, err := client.FetchCategory(ctx, "lang")
+	if err != nil || len(category) != 2 {
+		t.Errorf("FetchCategory() = %v, %v; want 2 products", category, err)
+	}
+
+	tag, err := client.FetchTag(ctx, "google")
+	if err != nil || len(tag) != 1 || tag[0].Name != "go" {
+		t.Errorf("FetchTag() = %v, %v; want go", tag, err)
+	}
+}
+
+func TestClient_V1Snapshot(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/v1/products":        `{"result": [{"name": "python"}]}`,
+		"/v1/products/python": v1Python,
+	})
+	client := NewClient(WithBaseURL(srv.URL), WithAPIVersion(APIV1))
+
+	snap, err := client.CreateSnapshot(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("CreateSnapshot() error = %v", err)
+	}
+	if snap.APIVersion != APIV1 {
+		t.Errorf("APIVersion = %q, want %q", snap.APIVersion, APIV1)
+	}
+
+	// The snapshot's API version wins over the client default
+	offline := NewClient(WithSnapshot(snap))
+	cycles, err := offline.FetchProduct(context.Background(), "python")
+	if err != nil {
+		t.Fatalf("FetchProduct() offline error = %v", err)
+	}
+	if len(cycles) != 2 {
+		t.Errorf("FetchProduct() offline returned %d cycles, want 2", len(cycles))
+	}
+}
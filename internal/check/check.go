@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package check
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/version"
+)
+
+// Verdict classifies the support state of a concrete version
+type Verdict int
+
+const (
+	// Supported means the cycle is fully supported and the version is the latest patch
+	Supported Verdict = iota
+	// OutdatedPatch means the cycle is supported but a newer patch release exists
+	OutdatedPatch
+	// SecurityOnly means active support ended and only security fixes are provided
+	SecurityOnly
+	// EOLSoon means the end of life or of active support is within the warning
+	// window, i.e. the cycle is api.StatusExpiring
+	EOLSoon
+	// EOL means the cycle reached its end of life
+	EOL
+)
+
+// Exit codes returned by the check command; 1 is reserved for errors
+const (
+	ExitSupported     = 0
+	ExitOutdatedPatch = 2
+	ExitSecurityOnly  = 3
+	ExitEOLSoon       = 4
+	ExitEOL           = 5
+	ExitUnknownCycle  = 6
+)
+
+// String returns a short label for the verdict
+func (v Verdict) String() string {
+	switch v {
+	case Supported:
+		return "supported"
+	case OutdatedPatch:
+		return "outdated patch"
+	case SecurityOnly:
+		return "security-only"
+	case EOLSoon:
+		return "EOL soon"
+	case EOL:
+		return "EOL"
+	default:
+		return "unknown"
+	}
+}
+
+// ExitCode returns the documented exit code for the verdict
+func (v Verdict) ExitCode() int {
+	switch v {
+	case Supported:
+		return ExitSupported
+	case OutdatedPatch:
+		return ExitOutdatedPatch
+	case SecurityOnly:
+		return ExitSecurityOnly
+	case EOLSoon:
+		return ExitEOLSoon
+	case EOL:
+		return ExitEOL
+	default:
+		return 1
+	}
+}
+
+// Result is the outcome of checking a version against its release cycle
+type Result struct {
+	Cycle   api.Cycle
+	Version string
+	Verdict Verdict
+	// DaysLeft is the number of days until the end of life, negative once passed.
+	// It is only meaningful if HasEOLDate is true.
+	DaysLeft   int
+	HasEOLDate bool
+	// SupportEnding is true if the verdict is EOLSoon because active support,
+	// not the cycle, ends within the warning window; SupportDaysLeft counts
+	// the days until then
+	SupportEnding   bool
+	SupportDaysLeft int
+	// Behind is the number of releases Version lags behind the cycle's latest
+	Behind int
+	// Outdated is true if a newer patch release than Version exists
+	Outdated bool
+}
+
+// Evaluate determines the verdict for v, which belongs to cycle, at now.
+// The support state is the cycle's api.Status, so the verdict agrees with
+// the status of the other outputs; only the patch level is checked here.
+func Evaluate(cycle api.Cycle, v string, now time.Time, warnDays int) Result {
+	r := Result{
+		Cycle:   cycle,
+		Version: v,
+	}
+
+	// A bare cycle name ("3.13") carries no patch level to compare
+	if version.Normalize(v) != version.Normalize(cycle.Cycle) {
+		if n, ok := version.Behind(v, cycle.Latest); ok && n > 0 {
+			r.Behind = n
+			r.Outdated = true
+		}
+	}
+
+	if !cycle.EOL.IsBoolean && !cycle.EOL.DateValue.IsZero() {
+		r.HasEOLDate = true
+		r.DaysLeft = api.DaysUntil(now, cycle.EOL.DateValue)
+	}
+
+	switch cycle.StatusAt(now, warnDays) {
+	case api.StatusEOL:
+		r.Verdict = EOL
+	case api.StatusExpiring:
+		r.Verdict = EOLSoon
+		if !r.HasEOLDate || r.DaysLeft > warnDays {
+			r.SupportEnding = true
+			r.SupportDaysLeft = api.DaysUntil(now, cycle.Support.DateValue)
+		}
+	case api.StatusSecurityOnly:
+		r.Verdict = SecurityOnly
+	case api.StatusActive:
+		r.Verdict = Supported
+		if r.Outdated {
+			r.Verdict = OutdatedPatch
+		}
+	}
+
+	return r
+}
+
+// Message returns a one-line, human-readable verdict for product
+func (r Result) Message(product string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s (cycle %s): ", product, r.Version, r.Cycle.Cycle)
+
+	switch r.Verdict {
+	case EOL:
+		if r.HasEOLDate {
+			fmt.Fprintf(&b, "EOL since %s", r.Cycle.EOL.DateValue.Format("2006-01-02"))
+		} else {
+			b.WriteString("EOL")
+		}
+	case EOLSoon:
+		if r.SupportEnding {
+			fmt.Fprintf(&b, "active support ends in %d days on %s", r.SupportDaysLeft, r.Cycle.Support.DateValue.Format("2006-01-02"))
+		} else {
+			fmt.Fprintf(&b, "EOL in %d days on %s", r.DaysLeft, r.Cycle.EOL.DateValue.Format("2006-01-02"))
+		}
+	case SecurityOnly:
+		fmt.Fprintf(&b, "security fixes only since %s", r.Cycle.Support.DateValue.Format("2006-01-02"))
+	case OutdatedPatch, Supported:
+		b.WriteString("supported")
+		if r.HasEOLDate {
+			fmt.Fprintf(&b, " until %s", r.Cycle.EOL.DateValue.Format("2006-01-02"))
+		}
+	}
+
+	if r.Outdated {
+		fmt.Fprintf(&b, ", %d %s behind latest %s", r.Behind, plural(r.Behind, "release", "releases"), r.Cycle.Latest)
+	}
+
+	return b.String()
+}
+
+// plural returns singular if n is 1 and plural otherwise
+func plural(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package check
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestEvaluate(t *testing.T) {
+	now := date(2025, 10, 7)
+
+	tests := []struct {
+		name     string
+		cycle    api.Cycle
+		version  string
+		want     Verdict
+		wantCode int
+	}{
+		{
+			name: "supported",
+			cycle: api.Cycle{
+				Cycle:   "3.13",
+				Latest:  "3.13.11",
+				Support: api.EOLValue{DateValue: date(2026, 10, 1)},
+				EOL:     api.EOLValue{DateValue: date(2029, 10, 31)},
+			},
+			version:  "3.13.11",
+			want:     Supported,
+			wantCode: 0,
+		},
+		{
+			name: "outdated patch",
+			cycle: api.Cycle{
+				Cycle:   "3.13",
+				Latest:  "3.13.11",
+				Support: api.EOLValue{DateValue: date(2026, 10, 1)},
+				EOL:     api.EOLValue{DateValue: date(2029, 10, 31)},
+			},
+			version:  "3.13.2",
+			want:     OutdatedPatch,
+			wantCode: 2,
+		},
+		{
+			name: "image variant tag",
+			cycle: api.Cycle{
+				Cycle:   "3.13",
+				Latest:  "3.13.11",
+				Support: api.EOLValue{DateValue: date(2026, 10, 1)},
+				EOL:     api.EOLValue{DateValue: date(2029, 10, 31)},
+			},
+			version:  "3.13.11-slim-bookworm",
+			want:     Supported,
+			wantCode: 0,
+		},
+		{
+			name: "bare cycle name",
+			cycle: api.Cycle{
+				Cycle:  "3.13",
+				Latest: "3.13.11",
+				EOL:    api.EOLValue{DateValue: date(2029, 10, 31)},
+			},
+			version:  "3.13",
+			want:     Supported,
+			wantCode: 0,
+		},
+		{
+			name: "security only",
+			cycle: api.Cycle{
+				Cycle:   "3.12",
+				Latest:  "3.12.12",
+				Support: api.EOLValue{DateValue: date(2025, 4, 2)},
+				EOL:     api.EOLValue{DateValue: date(2028, 10, 31)},
+			},
+			version:  "3.12.12",
+			want:     SecurityOnly,
+			wantCode: 3,
+		},
+		{
+			name: "EOL soon",
+			cycle: api.Cycle{
+				Cycle:  "20",
+				Latest: "20.19.5",
+				EOL:    api.EOLValue{DateValue: date(2025, 12, 1)},
+			},
+			version:  "20.19.5",
+			want:     EOLSoon,
+			wantCode: 4,
+		},
+		{
+			name: "active support ends within the window",
+			cycle: api.Cycle{
+				Cycle:   "3.13",
+				Latest:  "3.13.11",
+				Support: api.EOLValue{DateValue: date(2025, 12, 1)},
+				EOL:     api.EOLValue{DateValue: date(2029, 10, 31)},
+			},
+			version:  "3.13.11",
+			want:     EOLSoon,
+			wantCode: 4,
+		},
+		{
+			name: "EOL on the last day of the window",
+			cycle: api.Cycle{
+				Cycle:  "20",
+				Latest: "20.19.5",
+				EOL:    api.EOLValue{DateValue: date(2026, 1, 5)},
+			},
+			version:  "20.19.5",
+			want:     EOLSoon,
+			wantCode: 4,
+		},
+		{
+			name: "EOL one day after the window",
+			cycle: api.Cycle{
+				Cycle:  "20",
+				Latest: "20.19.5",
+				EOL:    api.EOLValue{DateValue: date(2026, 1, 6)},
+			},
+			version:  "20.19.5",
+			want:     Supported,
+			wantCode: 0,
+		},
+		{
+			name: "EOL date",
+			cycle: api.Cycle{
+				Cycle:  "18",
+				Latest: "18.20.8",
+				EOL:    api.EOLValue{DateValue: date(2025, 4, 30)},
+			},
+			version:  "18.20.4",
+			want:     EOL,
+			wantCode: 5,
+		},
+		{
+			name:     "EOL boolean",
+			cycle:    api.Cycle{Cycle: "2.7", EOL: api.EOLValue{IsBoolean: true, BoolValue: true}},
+			version:  "2.7",
+			want:     EOL,
+			wantCode: 5,
+		},
+		{
+			name:     "no latest known",
+			cycle:    api.Cycle{Cycle: "1.0", EOL: api.EOLValue{IsBoolean: true, BoolValue: false}},
+			version:  "1.0.3",
+			want:     Supported,
+			wantCode: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Evaluate(tt.cycle, tt.version, now, 90)
+			if got.Verdict != tt.want {
+				t.Errorf("Evaluate() verdict = %v, want %v", got.Verdict, tt.want)
+			}
+			if code := got.Verdict.ExitCode(); code != tt.wantCode {
+				t.Errorf("ExitCode() = %d, want %d", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestResult_Message(t *testing.T) {
+	now := date(2025, 10, 7)
+
+	tests := []struct {
+		name    string
+		cycle   api.Cycle
+		version string
+		want    string
+	}{
+		{
+			name:    "supported",
+			cycle:   api.Cycle{Cycle: "22", Latest: "22.20.0", EOL: api.EOLValue{DateValue: date(2027, 4, 30)}},
+			version: "22.20.0",
+			want:    "nodejs 22.20.0 (cycle 22): supported until 2027-04-30",
+		},
+		{
+			name:    "outdated",
+			cycle:   api.Cycle{Cycle: "22", Latest: "22.20.0", EOL: api.EOLValue{DateValue: date(2027, 4, 30)}},
+			version: "22.1.0",
+			want:    "nodejs 22.1.0 (cycle 22): supported until 2027-04-30, 19 releases behind latest 22.20.0",
+		},
+		{
+			name:    "one release behind",
+			cycle:   api.Cycle{Cycle: "22", Latest: "22.20.1", EOL: api.EOLValue{DateValue: date(2027, 4, 30)}},
+			version: "22.20.0",
+			want:    "nodejs 22.20.0 (cycle 22): supported until 2027-04-30, 1 release behind latest 22.20.1",
+		},
+		{
+			name:    "EOL soon",
+			cycle:   api.Cycle{Cycle: "20", Latest: "20.19.5", EOL: api.EOLValue{DateValue: date(2025, 11, 6)}},
+			version: "20.19.5",
+			want:    "nodejs 20.19.5 (cycle 20): EOL in 30 days on 2025-11-06",
+		},
+		{
+			name: "active support ends soon",
+			cycle: api.Cycle{
+				Cycle:   "22",
+				Latest:  "22.20.0",
+				Support: api.EOLValue{DateValue: date(2025, 10, 21)},
+				EOL:     api.EOLValue{DateValue: date(2027, 4, 30)},
+			},
+			version: "22.20.0",
+			want:    "nodejs 22.20.0 (cycle 22): active support ends in 14 days on 2025-10-21",
+		},
+		{
+			name:    "EOL",
+			cycle:   api.Cycle{Cycle: "18", Latest: "18.20.8", EOL: api.EOLValue{DateValue: date(2025, 4, 30)}},
+			version: "18.20.8",
+			want:    "nodejs 18.20.8 (cycle 18): EOL since 2025-04-30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Evaluate(tt.cycle, tt.version, now, 90).Message("nodejs")
+			if got != tt.want {
+				t.Errorf("Message() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerdict_String(t *testing.T) {
+	for _, v := range []Verdict{Supported, OutdatedPatch, SecurityOnly, EOLSoon, EOL} {
+		if s := v.String(); s == "" || strings.Contains(s, "unknown") {
+			t.Errorf("Verdict(%d).String() = %q", v, s)
+		}
+	}
+}
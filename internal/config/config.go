@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvConfigFile overrides the location of the user configuration file
+const EnvConfigFile = "EOL_DATE_CONFIG"
+
+// Setting is a global option that can be set in a configuration file and
+// through an environment variable. Key is also the name of the flag.
+type Setting struct {
+	Key     string
+	Env     string
+	Default string
+	Usage   string
+}
+
+// Settings lists all configurable options in the order shown by 'config show'
+var Settings = []Setting{
+	{Key: "format", Env: "EOL_DATE_FORMAT", Default: "table", Usage: "output format"},
+	{Key: "all", Env: "EOL_DATE_ALL", Default: "false", Usage: "show end-of-life cycles"},
+	{Key: "columns", Env: "EOL_DATE_COLUMNS", Usage: "table columns, comma-separated"},
+	{Key: "theme", Env: "EOL_DATE_THEME", Default: "dark", Usage: "color theme"},
+	{Key: "api-url", Env: "EOL_DATE_API_URL", Default: api.DefaultBaseURL, Usage: "API base URL"},
+	{Key: "cache-ttl", Env: "EOL_DATE_CACHE_TTL", Default: api.DefaultCacheTTL.String(), Usage: "cache lifetime"},
+	{Key: "warn-days", Env: "EOL_DATE_WARN_DAYS", Default: strconv.Itoa(api.DefaultWarnDays), Usage: "expiring and EOL soon window in days"},
+}
+
+// manifestKeys are the keys of the project file that belong to the manifest
+var manifestKeys = []string{"policy", "products"}
+
+// Layer holds the values read from one configuration file
+type Layer struct {
+	Values map[string]string
+	// Path is the file the values were read from
+	Path string
+	// Found is false if the file does not exist
+	Found bool
+}
+
+// Config holds the configuration files, in increasing order of precedence
+type Config struct {
+	User    Layer
+	Project Layer
+	// lookupEnv returns the value of an environment variable; replaced in tests
+	lookupEnv func(string) (string, bool)
+}
+
+// Load reads the user configuration file and the project file in the
+// current directory. Missing files are not an error.
+func Load() (*Config, error) {
+	userPath, err := UserFile()
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := loadLayer(userPath, false)
+	if err != nil {
+		return nil, err
+	}
+	project, err := loadLayer(manifest.DefaultFile, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{User: user, Project: project, lookupEnv: os.LookupEnv}, nil
+}
+
+// UserFile returns the path of the user configuration file:
+// $EOL_DATE_CONFIG, or config.yaml in $XDG_CONFIG_HOME/eol-date
+// (default ~/.config/eol-date)
+func UserFile() (string, error) {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path, nil
+	}
+
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to determine config directory: %w", err)
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "eol-date", "config.yaml"), nil
+}
+
+// AliasFile returns the path of the user's alias file, aliases.yaml next to
+// the user configuration file
+func AliasFile() (string, error) {
+	path, err := UserFile()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), "aliases.yaml"), nil
+}
+
+// LoadAliases reads an alias file mapping alternative names to product
+// names, e.g. "pg: postgresql". A missing file yields no aliases.
+func LoadAliases(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is the well-known alias file location
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aliases: %w", err)
+	}
+
+	var aliases map[string]string
+	if err := yaml.Unmarshal(data, &aliases); err != nil {
+		return nil, fmt.Errorf("failed to parse aliases %s: %w", path, err)
+	}
+	return aliases, nil
+}
+
+// loadLayer reads the configuration file at path. The project file also
+// holds the manifest, whose keys are skipped.
+func loadLayer(path string, project bool) (Layer, error) {
+	layer := Layer{Path: path, Values: make(map[string]string)}
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is the well-known config location
+	if errors.Is(err, fs.ErrNotExist) {
+		return layer, nil
+	}
+	if err != nil {
+		return layer, fmt.Errorf("failed to read config: %w", err)
+	}
+	layer.Found = true
+
+	values, err := Parse(data, project)
+	if err != nil {
+		return layer, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	layer.Values = values
+	return layer, nil
+}
+
+// Parse reads the settings of a configuration document as strings in the
+// form the flags accept. Lists are joined with commas. If project is true,
+// the manifest keys are allowed and skipped.
+func Parse(data []byte, project bool) (map[string]string, error) {
+	var doc map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(doc))
+	for key, node := range doc {
+		if _, ok := lookupSetting(key); !ok {
+			if project && slices.Contains(manifestKeys, key) {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: unknown setting '%s'", node.Line, key)
+		}
+
+		switch node.Kind {
+		case yaml.ScalarNode:
+			values[key] = node.Value
+		case yaml.SequenceNode:
+			items := make([]string, 0, len(node.Content))
+			for _, item := range node.Content {
+				if item.Kind != yaml.ScalarNode {
+					return nil, fmt.Errorf("line %d: %s must be a list of strings", item.Line, key)
+				}
+				items = append(items, item.Value)
+			}
+			values[key] = strings.Join(items, ",")
+		case yaml.DocumentNode, yaml.MappingNode, yaml.AliasNode:
+			return nil, fmt.Errorf("line %d: %s must be a value or a list", node.Line, key)
+		}
+	}
+	return values, nil
+}
+
+// FileValue returns the value of key from the project file or, if unset
+// there, from the user configuration file
+func (c *Config) FileValue(key string) (value, path string, ok bool) {
+	for _, layer := range []Layer{c.Project, c.User} {
+		if v, ok := layer.Values[key]; ok {
+			return v, layer.Path, true
+		}
+	}
+	return "", "", false
+}
+
+// Resolve returns the value of key from the environment or the files and
+// where it came from. ok is false if neither sets the key.
+func (c *Config) Resolve(key string) (value, source string, ok bool) {
+	if s, found := lookupSetting(key); found && c.lookupEnv != nil {
+		if v, ok := c.lookupEnv(s.Env); ok {
+			return v, "$" + s.Env, true
+		}
+	}
+	return c.FileValue(key)
+}
+
+// lookupSetting returns the setting named key
+func lookupSetting(key string) (Setting, bool) {
+	for _, s := range Settings {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return Setting{}, false
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		project bool
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "scalars and lists",
+			data: "format: markdown\nall: true\nwarn-days: 180\ncolumns: [cycle, latest, eol]\n",
+			want: map[string]string{"format": "markdown", "all": "true", "warn-days": "180", "columns": "cycle,latest,eol"},
+		},
+		{
+			name:    "manifest keys in project file",
+			data:    "theme: light\npolicy:\n  fail-on: eol\nproducts:\n  python: \"3.12\"\n",
+			project: true,
+			want:    map[string]string{"theme": "light"},
+		},
+		{name: "manifest keys in user file", data: "products:\n  python: \"3.12\"\n", wantErr: "unknown setting 'products'"},
+		{name: "unknown setting", data: "colour: red\n", wantErr: "line 1: unknown setting 'colour'"},
+		{name: "mapping value", data: "format:\n  name: json\n", wantErr: "format must be a value or a list"},
+		{name: "empty document", data: "", want: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse([]byte(tt.data), tt.project)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Parse() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	cfg := &Config{
+		User:    Layer{Path: "config.yaml", Values: map[string]string{"format": "csv", "theme": "light", "all": "true"}},
+		Project: Layer{Path: ".eol-date.yaml", Values: map[string]string{"format": "markdown", "theme": "none"}},
+		lookupEnv: func(name string) (string, bool) {
+			if name == "EOL_DATE_THEME" {
+				return "dark", true
+			}
+			return "", false
+		},
+	}
+
+	tests := []struct {
+		key        string
+		wantValue  string
+		wantSource string
+		wantOK     bool
+	}{
+		{key: "theme", wantValue: "dark", wantSource: "$EOL_DATE_THEME", wantOK: true},
+		{key: "format", wantValue: "markdown", wantSource: ".eol-date.yaml", wantOK: true},
+		{key: "all", wantValue: "true", wantSource: "config.yaml", wantOK: true},
+		{key: "warn-days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			value, source, ok := cfg.Resolve(tt.key)
+			if value != tt.wantValue || source != tt.wantSource || ok != tt.wantOK {
+				t.Errorf("Resolve(%q) = %q, %q, %v, want %q, %q, %v",
+					tt.key, value, source, ok, tt.wantValue, tt.wantSource, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestUserFile(t *testing.T) {
+	t.Setenv(EnvConfigFile, "")
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	if got, _ := UserFile(); got != filepath.Join("/xdg", "eol-date", "config.yaml") {
+		t.Errorf("UserFile() = %q, want file in XDG_CONFIG_HOME", got)
+	}
+
+	t.Setenv(EnvConfigFile, "/etc/eol-date.yaml")
+	if got, _ := UserFile(); got != "/etc/eol-date.yaml" {
+		t.Errorf("UserFile() = %q, want %s", got, "/etc/eol-date.yaml")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(EnvConfigFile, filepath.Join(dir, "missing.yaml"))
+	t.Chdir(dir)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.User.Found || cfg.Project.Found {
+		t.Errorf("Load() found files in an empty directory: %+v", cfg)
+	}
+}
+
+func TestLoadAliases(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(EnvConfigFile, filepath.Join(dir, "config.yaml"))
+
+	path, err := AliasFile()
+	if err != nil {
+		t.Fatalf("AliasFile() error = %v", err)
+	}
+	if path != filepath.Join(dir, "aliases.yaml") {
+		t.Errorf("AliasFile() = %q, want aliases.yaml next to the config file", path)
+	}
+
+	aliases, err := LoadAliases(path)
+	if err != nil || aliases != nil {
+		t.Errorf("LoadAliases() missing file = %v, %v; want no aliases", aliases, err)
+	}
+
+	if err := os.WriteFile(path, []byte("pg: postgresql\nk8s: kubernetes\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	aliases, err = LoadAliases(path)
+	if err != nil {
+		t.Fatalf("LoadAliases() error = %v", err)
+	}
+	if want := map[string]string{"pg": "postgresql", "k8s": "kubernetes"}; !reflect.DeepEqual(aliases, want) {
+		t.Errorf("LoadAliases() = %v, want %v", aliases, want)
+	}
+
+	if err := os.WriteFile(path, []byte("- pg\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadAliases(path); err == nil {
+		t.Error("LoadAliases() accepted a list")
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/ui"
+)
+
+// Exporter periodically fetches release cycles and serves them as OpenMetrics
+type Exporter struct {
+	refreshed time.Time
+	client    *api.Client
+	// now returns the current time; replaced in tests
+	now      api.Clock
+	products []string
+	data     []ui.ProductCycles
+	mu       sync.RWMutex
+}
+
+// New creates an exporter for products that fetches data through client
+func New(client *api.Client, products []string) *Exporter {
+	return &Exporter{
+		client:   client,
+		products: products,
+		now:      api.SystemClock,
+	}
+}
+
+// Refresh fetches the cycles of all products. On error the previously
+// fetched data is kept, so scrapes continue to see the last known state.
+func (e *Exporter) Refresh(ctx context.Context) error {
+	data := make([]ui.ProductCycles, 0, len(e.products))
+	for _, p := range e.products {
+		cycles, err := e.client.FetchProduct(ctx, p)
+		if err != nil {
+			return fmt.Errorf("failed to refresh %s: %w", p, err)
+		}
+		data = append(data, ui.ProductCycles{Product: p, Cycles: cycles})
+	}
+
+	e.mu.Lock()
+	e.data = data
+	e.refreshed = e.now()
+	e.mu.Unlock()
+
+	return nil
+}
+
+// ServeHTTP writes the metrics of the last successful refresh
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.refreshed.IsZero() {
+		http.Error(w, "no data fetched yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", ui.OpenMetricsContentType)
+	_ = ui.WriteOpenMetrics(w, e.data, e.refreshed, e.now())
+}
+
+// ValidateInterval returns an error unless interval is positive, as Run
+// requires
+func ValidateInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid --interval %s: must be positive", interval)
+	}
+	return nil
+}
+
+// Run refreshes the data every interval until ctx is canceled. Refresh
+// errors are passed to onError and do not stop the loop.
+func (e *Exporter) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.Refresh(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				onError(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package exporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/ui"
+)
+
+// newUpstream fakes the endoflife.date API; fail makes every request fail
+func newUpstream(t *testing.T, fail *atomic.Bool) *httptest.Server {
+	t.Helper()
+	responses := map[string]string{
+		"/python.json": `[{"cycle":"3.13","latest":"3.13.11","eol":"2029-10-31","support":"2026-10-01","lts":false},` +
+			`{"cycle":"2.7","latest":"2.7.18","eol":"2020-01-01","lts":false}]`,
+		"/nodejs.json": `[{"cycle":"22","latest":"22.12.0","eol":"2027-04-30","lts":"2024-10-29"}]`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok || fail.Load() {
+			http.Error(w, "unavailable", http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func scrape(t *testing.T, e *Exporter) (int, string, string) {
+	t.Helper()
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + "/metrics") //nolint:noctx // test request
+	if err != nil {
+		t.Fatalf("GET /metrics error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, resp.Header.Get("Content-Type"), string(body)
+}
+
+func TestExporter(t *testing.T) {
+	var fail atomic.Bool
+	upstream := newUpstream(t, &fail)
+
+	e := New(api.NewClient(api.WithBaseURL(upstream.URL)), []string{"python", "nodejs"})
+	refreshed := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return refreshed }
+
+	if status, _, _ := scrape(t, e); status != http.StatusServiceUnavailable {
+		t.Errorf("scrape before refresh status = %d, want %d", status, http.StatusServiceUnavailable)
+	}
+
+	if err := e.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	status, contentType, body := scrape(t, e)
+	if status != http.StatusOK {
+		t.Fatalf("scrape status = %d, want %d", status, http.StatusOK)
+	}
+	if contentType != ui.OpenMetricsContentType {
+		t.Errorf("Content-Type = %q, want %q", contentType, ui.OpenMetricsContentType)
+	}
+	for _, want := range []string{
+		`eol_date_days_until_eol{product="python",cycle="3.13"} 1385`,
+		`eol_date_is_eol{product="python",cycle="2.7"} 1`,
+		`eol_date_is_eol{product="nodejs",cycle="22"} 0`,
+		`eol_date_is_lts{product="nodejs",cycle="22"} 1`,
+		"eol_date_last_refresh_timestamp 1768478400",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %q:\n%s", want, body)
+		}
+	}
+
+	// A failed refresh keeps serving the previous data
+	fail.Store(true)
+	e.now = func() time.Time { return refreshed.Add(time.Hour) }
+	if err := e.Refresh(context.Background()); err == nil {
+		t.Fatal("Refresh() with failing upstream returned no error")
+	}
+	if _, _, body := scrape(t, e); !strings.Contains(body, "eol_date_last_refresh_timestamp 1768478400") {
+		t.Errorf("metrics after failed refresh do not keep the previous data:\n%s", body)
+	}
+}
+
+func TestExporter_Run(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	upstream := newUpstream(t, &fail)
+
+	e := New(api.NewClient(api.WithBaseURL(upstream.URL)), []string{"python"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx, 10*time.Millisecond, func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		})
+		close(done)
+	}()
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Error("Run() reported a nil error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not report the refresh error")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestValidateInterval(t *testing.T) {
+	if err := ValidateInterval(time.Minute); err != nil {
+		t.Errorf("ValidateInterval(1m) error = %v", err)
+	}
+	for _, d := range []time.Duration{0, -time.Hour} {
+		if err := ValidateInterval(d); err == nil {
+			t.Errorf("ValidateInterval(%s) accepted a non-positive interval", d)
+		}
+	}
+}
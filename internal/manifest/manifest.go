@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/oliverandrich/eol-date/internal/check"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is the manifest file name looked up in the current directory
+const DefaultFile = ".eol-date.yaml"
+
+// failOnValues maps the fail-on policy values to the least severe failing verdict
+var failOnValues = map[string]check.Verdict{
+	"outdated":      check.OutdatedPatch,
+	"security-only": check.SecurityOnly,
+	"eol-soon":      check.EOLSoon,
+	"eol":           check.EOL,
+}
+
+// Policy holds the rules of a manifest or an entry. Unset fields inherit
+// the value of the enclosing policy.
+type Policy struct {
+	// WarnDays is the window in days before the end of life that reports EOL soon
+	WarnDays *int `yaml:"warn-days"`
+	// RequireLTS fails entries whose release cycle is not an LTS release
+	RequireLTS *bool `yaml:"require-lts"`
+	// FailOn is the least severe verdict that fails: outdated, security-only, eol-soon or eol
+	FailOn string `yaml:"fail-on"`
+}
+
+// Entry is a product pinned to a version
+type Entry struct {
+	Product string
+	Version string
+	Policy  Policy
+	// Line is the line of the entry in the manifest
+	Line int
+}
+
+// Manifest lists the products a project uses and the policy they must meet
+type Manifest struct {
+	Policy   Policy
+	Products []Entry
+}
+
+// Rules is a fully resolved policy
+type Rules struct {
+	WarnDays   int
+	FailOn     check.Verdict
+	RequireLTS bool
+}
+
+// Load reads the manifest at path
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	m, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Parse parses a manifest document. Products map a name either to a version
+// or to an object with a version and policy overrides:
+//
+//	policy:
+//	  warn-days: 180
+//	  fail-on: eol
+//	products:
+//	  python: "3.11"
+//	  nodejs:
+//	    version: "20"
+//	    require-lts: true
+func Parse(data []byte) (*Manifest, error) {
+	var raw struct {
+		Policy   Policy    `yaml:"policy"`
+		Products yaml.Node `yaml:"products"`
+	}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if err := raw.Policy.validate(); err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{Policy: raw.Policy}
+	if raw.Products.Kind == 0 {
+		return m, nil
+	}
+	if raw.Products.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: products must be a mapping of product names to versions", raw.Products.Line)
+	}
+
+	// Walk the node instead of decoding into a map to keep the file order
+	nodes := raw.Products.Content
+	for i := 0; i+1 < len(nodes); i += 2 {
+		entry, err := parseEntry(nodes[i], nodes[i+1])
+		if err != nil {
+			return nil, err
+		}
+		m.Products = append(m.Products, entry)
+	}
+
+	return m, nil
+}
+
+// parseEntry parses a product entry given as "name: version" or
+// "name: {version: ..., <policy>}"
+func parseEntry(key, value *yaml.Node) (Entry, error) {
+	entry := Entry{Product: key.Value, Line: key.Line}
+
+	switch value.Kind {
+	case yaml.ScalarNode:
+		// Use the literal text, so "3.10" is not read as the float 3.1
+		entry.Version = value.Value
+	case yaml.MappingNode:
+		var fields struct {
+			Version yaml.Node `yaml:"version"`
+			Policy  `yaml:",inline"`
+		}
+		if err := value.Decode(&fields); err != nil {
+			return entry, err
+		}
+		entry.Version = fields.Version.Value
+		entry.Policy = fields.Policy
+	case yaml.DocumentNode, yaml.SequenceNode, yaml.AliasNode:
+		return entry, fmt.Errorf("line %d: %s must map to a version or an object", value.Line, entry.Product)
+	}
+
+	if strings.TrimSpace(entry.Version) == "" {
+		return entry, fmt.Errorf("line %d: %s has no version", value.Line, entry.Product)
+	}
+	if err := entry.Policy.validate(); err != nil {
+		return entry, fmt.Errorf("%s: %w", entry.Product, err)
+	}
+	return entry, nil
+}
+
+// validate checks the values of the policy fields
+func (p Policy) validate() error {
+	if p.FailOn != "" {
+		if _, ok := failOnValues[p.FailOn]; !ok {
+			return fmt.Errorf("invalid fail-on '%s' (valid: outdated, security-only, eol-soon, eol)", p.FailOn)
+		}
+	}
+	if p.WarnDays != nil && *p.WarnDays < 0 {
+		return errors.New("warn-days must not be negative")
+	}
+	return nil
+}
+
+// Rules returns the policy of e with unset fields taken from the manifest
+// policy and then from the defaults; warnDays is the window if no policy
+// sets one, i.e. the global --warn-days
+func (m *Manifest) Rules(e Entry, warnDays int) Rules {
+	r := Rules{WarnDays: warnDays, FailOn: check.EOL}
+	for _, p := range []Policy{m.Policy, e.Policy} {
+		if p.WarnDays != nil {
+			r.WarnDays = *p.WarnDays
+		}
+		if p.RequireLTS != nil {
+			r.RequireLTS = *p.RequireLTS
+		}
+		if p.FailOn != "" {
+			r.FailOn = failOnValues[p.FailOn]
+		}
+	}
+	return r
+}
+
+// Violations returns the rules broken by a checked version
+func (r Rules) Violations(res check.Result) []string {
+	var violations []string
+	if res.Verdict >= r.FailOn {
+		violations = append(violations, res.Verdict.String())
+	}
+	if r.RequireLTS && !res.Cycle.LTS.IsLTS() {
+		violations = append(violations, "not an LTS release")
+	}
+	return violations
+}
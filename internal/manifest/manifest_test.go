@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package manifest
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/check"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestLoad(t *testing.T) {
+	m, err := Load("testdata/eol-date.yaml")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	wantPolicy := Policy{WarnDays: ptr(180), FailOn: "eol"}
+	if !reflect.DeepEqual(m.Policy, wantPolicy) {
+		t.Errorf("Policy = %+v, want %+v", m.Policy, wantPolicy)
+	}
+
+	want := []Entry{
+		{Product: "python", Version: "3.10", Line: 7},
+		{Product: "postgresql", Version: "15", Line: 8},
+		{Product: "nodejs", Version: "20", Policy: Policy{RequireLTS: ptr(true), FailOn: "eol-soon"}, Line: 9},
+	}
+	if !reflect.DeepEqual(m.Products, want) {
+		t.Errorf("Products = %+v, want %+v", m.Products, want)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "invalid fail-on", data: "policy:\n  fail-on: never\n", want: "invalid fail-on 'never'"},
+		{name: "negative warn-days", data: "policy:\n  warn-days: -1\n", want: "warn-days must not be negative"},
+		{name: "products list", data: "products:\n  - python\n", want: "products must be a mapping"},
+		{name: "missing version", data: "products:\n  python:\n    require-lts: true\n", want: "python has no version"},
+		{name: "entry fail-on", data: "products:\n  go:\n    version: \"1.22\"\n    fail-on: later\n", want: "go: invalid fail-on"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRules(t *testing.T) {
+	m := &Manifest{Policy: Policy{WarnDays: ptr(180), RequireLTS: ptr(true)}}
+
+	tests := []struct {
+		name  string
+		entry Entry
+		want  Rules
+	}{
+		{name: "manifest policy", entry: Entry{}, want: Rules{WarnDays: 180, FailOn: check.EOL, RequireLTS: true}},
+		{
+			name:  "entry overrides",
+			entry: Entry{Policy: Policy{WarnDays: ptr(0), RequireLTS: ptr(false), FailOn: "security-only"}},
+			want:  Rules{WarnDays: 0, FailOn: check.SecurityOnly, RequireLTS: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Rules(tt.entry, 30); got != tt.want {
+				t.Errorf("Rules() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (&Manifest{}).Rules(Entry{}, 30); got.WarnDays != 30 || got.FailOn != check.EOL {
+		t.Errorf("Rules() without policy = %+v, want the given warn days and defaults", got)
+	}
+}
+
+func TestViolations(t *testing.T) {
+	now := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	lts := api.Cycle{Cycle: "20", Latest: "20.19.0", LTS: api.LTSValue{IsBoolean: true, BoolValue: true},
+		EOL: api.EOLValue{DateValue: time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)}}
+	eol := api.Cycle{Cycle: "3.8", Latest: "3.8.20", EOL: api.EOLValue{DateValue: time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)}}
+
+	tests := []struct {
+		name    string
+		rules   Rules
+		cycle   api.Cycle
+		version string
+		want    []string
+	}{
+		{name: "EOL soon below fail-on", rules: Rules{WarnDays: 180, FailOn: check.EOL}, cycle: lts, version: "20.19.0"},
+		{name: "EOL soon at fail-on", rules: Rules{WarnDays: 180, FailOn: check.EOLSoon}, cycle: lts, version: "20.19.0", want: []string{"EOL soon"}},
+		{name: "outdated patch", rules: Rules{FailOn: check.OutdatedPatch}, cycle: lts, version: "20.1.0", want: []string{"outdated patch"}},
+		{name: "EOL and not LTS", rules: Rules{FailOn: check.EOL, RequireLTS: true}, cycle: eol, version: "3.8", want: []string{"EOL", "not an LTS release"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := check.Evaluate(tt.cycle, tt.version, now, tt.rules.WarnDays)
+			if got := tt.rules.Violations(res); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Violations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/search"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// newTestServer returns an MCP server backed by a stubbed endoflife.date API
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	responses := map[string]string{
+		"/all.json": `["nodejs","python"]`,
+		"/python.json": `[` +
+			`{"cycle":"3.13","releaseDate":"2024-10-07","latest":"3.13.11","eol":"2029-10-31","support":"2026-10-01","lts":false},` +
+			`{"cycle":"3.12","releaseDate":"2023-10-02","latest":"3.12.12","eol":"2028-10-31","support":"2025-04-02","lts":false},` +
+			`{"cycle":"2.7","releaseDate":"2010-07-03","latest":"2.7.18","eol":"2020-01-01","support":"2015-01-01","lts":false}]`,
+	}
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(upstream.Close)
+
+	aliases := search.BuiltinAliases.With(map[string]string{"snake": "python"})
+	s := NewServer(api.NewClient(api.WithBaseURL(upstream.URL)), aliases, "test")
+	s.now = func() time.Time { return time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC) }
+	return s
+}
+
+func TestServe_Session(t *testing.T) {
+	in, err := os.ReadFile("testdata/session.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := newTestServer(t).Serve(context.Background(), bytes.NewReader(in), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	const golden = "testdata/session.golden"
+	if *update {
+		if err := os.WriteFile(golden, out.Bytes(), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("Serve() output does not match %s:\n%s", golden, out.String())
+	}
+}
+
+func TestServe_NotificationsAreNotAnswered(t *testing.T) {
+	in := `{"jsonrpc":"2.0","method":"notifications/initialized"}` + "\n" +
+		`{"jsonrpc":"2.0","method":"notifications/cancelled","params":{"requestId":1}}` + "\n"
+
+	var out bytes.Buffer
+	if err := newTestServer(t).Serve(context.Background(), strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Serve() answered notifications: %s", out.String())
+	}
+}
+
+func TestServe_InitializeUnknownVersion(t *testing.T) {
+	in := `{"jsonrpc":"2.0","id":"a","method":"initialize","params":{"protocolVersion":"1999-01-01"}}` + "\n"
+
+	var out bytes.Buffer
+	if err := newTestServer(t).Serve(context.Background(), strings.NewReader(in), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	if !strings.Contains(out.String(), `"id":"a"`) || !strings.Contains(out.String(), `"protocolVersion":"`+ProtocolVersion+`"`) {
+		t.Errorf("initialize with unknown version = %s, want latest version", out.String())
+	}
+}
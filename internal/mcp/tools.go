@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/check"
+	"github.com/oliverandrich/eol-date/internal/search"
+	"github.com/oliverandrich/eol-date/internal/ui"
+	"github.com/oliverandrich/eol-date/internal/version"
+)
+
+// tool describes a tool in the tools/list response
+type tool struct {
+	InputSchema map[string]any `json:"inputSchema"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+}
+
+// objectSchema returns a JSON schema for an object with the given properties
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// toolDefinitions lists the tools offered by the server
+var toolDefinitions = []tool{
+	{
+		Name:        "list_products",
+		Description: "List the names of all products known to endoflife.date.",
+		InputSchema: objectSchema(map[string]any{}),
+	},
+	{
+		Name:        "search_products",
+		Description: "Find product names matching a search term, best match first. Tolerates typos, e.g. 'postgress' finds 'postgresql'.",
+		InputSchema: objectSchema(map[string]any{
+			"query": map[string]any{"type": "string", "description": "Search term"},
+			"limit": map[string]any{"type": "integer", "description": "Maximum number of results (default 10)"},
+		}, "query"),
+	},
+	{
+		Name: "get_cycles",
+		Description: "Get the release cycles of a product with release, support and end-of-life dates, " +
+			"LTS flag, latest version and computed status.",
+		InputSchema: objectSchema(map[string]any{
+			"product":   map[string]any{"type": "string", "description": "Exact product name, e.g. 'python'"},
+			"all":       map[string]any{"type": "boolean", "description": "Include cycles that reached their end of life"},
+			"warn_days": map[string]any{"type": "integer", "description": "Report cycles whose end of life or of active support is within this many days as 'expiring' (default 90)"},
+		}, "product"),
+	},
+	{
+		Name:        "check_version",
+		Description: "Check whether a concrete version of a product is still supported and up to date.",
+		InputSchema: objectSchema(map[string]any{
+			"product":   map[string]any{"type": "string", "description": "Exact product name, e.g. 'nodejs'"},
+			"version":   map[string]any{"type": "string", "description": "Installed version, e.g. '18.20.4'"},
+			"warn_days": map[string]any{"type": "integer", "description": "Report an end of life or of active support within this many days as 'EOL soon' (default 90)"},
+		}, "product", "version"),
+	},
+}
+
+// toolArgs holds the arguments of all tools
+type toolArgs struct {
+	Query    string `json:"query"`
+	Product  string `json:"product"`
+	Version  string `json:"version"`
+	Limit    int    `json:"limit"`
+	WarnDays *int   `json:"warn_days"`
+	All      bool   `json:"all"`
+}
+
+// warnDays returns the warn_days argument or the default window
+func (a toolArgs) warnDays() int {
+	if a.WarnDays != nil {
+		return *a.WarnDays
+	}
+	return api.DefaultWarnDays
+}
+
+// callTool runs a tool. Failures of the tool itself are reported in the
+// result with isError, so the model can see and react to them.
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, *rpcError) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	var args toolArgs
+	if len(p.Arguments) > 0 {
+		if err := json.Unmarshal(p.Arguments, &args); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("invalid arguments: %v", err)}
+		}
+	}
+
+	var (
+		result any
+		err    error
+	)
+	switch p.Name {
+	case "list_products":
+		result, err = s.listProducts(ctx)
+	case "search_products":
+		result, err = s.searchProducts(ctx, args)
+	case "get_cycles":
+		result, err = s.getCycles(ctx, args)
+	case "check_version":
+		result, err = s.checkVersion(ctx, args)
+	default:
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", p.Name)}
+	}
+
+	if err != nil {
+		return map[string]any{
+			"content": []map[string]any{{"type": "text", "text": err.Error()}},
+			"isError": true,
+		}, nil
+	}
+
+	text, err := json.Marshal(result)
+	if err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: err.Error()}
+	}
+	return map[string]any{
+		"content":           []map[string]any{{"type": "text", "text": string(text)}},
+		"structuredContent": json.RawMessage(text),
+		"isError":           false,
+	}, nil
+}
+
+func (s *Server) listProducts(ctx context.Context) (any, error) {
+	products, err := s.client.FetchProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"products": products}, nil
+}
+
+func (s *Server) searchProducts(ctx context.Context, args toolArgs) (any, error) {
+	if args.Query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	limit := args.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	products, err := s.client.FetchProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := search.Names(search.FindSimilar(products, args.Query, limit))
+	if matches == nil {
+		matches = []string{}
+	}
+	return map[string]any{"products": matches}, nil
+}
+
+// findProduct resolves a product name or a common alias of it
+func (s *Server) findProduct(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("product is required")
+	}
+
+	products, err := s.client.FetchProducts(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	product, found := search.Resolve(products, s.aliases, name)
+	if !found {
+		msg := fmt.Sprintf("unknown product '%s'", name)
+		if matches := search.Names(search.FindSimilar(products, name, 5)); len(matches) > 0 {
+			msg += fmt.Sprintf(" (did you mean: %s?)", strings.Join(matches, ", "))
+		}
+		return "", fmt.Errorf("%s", msg)
+	}
+	return product, nil
+}
+
+func (s *Server) getCycles(ctx context.Context, args toolArgs) (any, error) {
+	product, err := s.findProduct(ctx, args.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles, err := s.client.FetchProduct(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same document as --format json
+	var buf bytes.Buffer
+	if err := ui.WriteJSON(&buf, product, cycles, ui.Options{ShowAll: args.All, WarnDays: args.warnDays()}, s.now()); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(bytes.TrimSpace(buf.Bytes())), nil
+}
+
+func (s *Server) checkVersion(ctx context.Context, args toolArgs) (any, error) {
+	if args.Version == "" {
+		return nil, fmt.Errorf("version is required")
+	}
+	product, err := s.findProduct(ctx, args.Product)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles, err := s.client.FetchProduct(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	cycle, ok := version.Match(cycles, args.Version)
+	if !ok {
+		return nil, fmt.Errorf("%s %s: no matching release cycle", product, args.Version)
+	}
+
+	r := check.Evaluate(cycle, args.Version, s.now(), args.warnDays())
+
+	result := map[string]any{
+		"product":  product,
+		"version":  args.Version,
+		"cycle":    cycle,
+		"verdict":  r.Verdict.String(),
+		"message":  r.Message(product),
+		"outdated": r.Outdated,
+		"behind":   r.Behind,
+	}
+	if r.HasEOLDate {
+		result["daysUntilEOL"] = r.DaysLeft
+	}
+	return result, nil
+}
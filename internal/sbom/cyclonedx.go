@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package sbom
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// cdxComponent is a CycloneDX component; JSON and XML share the same shape
+type cdxComponent struct {
+	Name       string         `json:"name"       xml:"name"`
+	Version    string         `json:"version"    xml:"version"`
+	PURL       string         `json:"purl"       xml:"purl"`
+	BOMRef     string         `json:"bom-ref"    xml:"bom-ref,attr"`
+	Components []cdxComponent `json:"components" xml:"components>component"`
+}
+
+// cdxBOM is the part of a CycloneDX document that lists components
+type cdxBOM struct {
+	Metadata struct {
+		Component *cdxComponent `json:"component" xml:"component"`
+	} `json:"metadata" xml:"metadata"`
+	Components []cdxComponent `json:"components" xml:"components>component"`
+}
+
+func parseCycloneDXJSON(data []byte) ([]Component, error) {
+	var bom cdxBOM
+	if err := json.Unmarshal(data, &bom); err != nil {
+		return nil, fmt.Errorf("invalid CycloneDX JSON: %w", err)
+	}
+	return bom.flatten(), nil
+}
+
+func parseCycloneDXXML(data []byte) ([]Component, error) {
+	var bom cdxBOM
+	if err := xml.Unmarshal(data, &bom); err != nil {
+		return nil, fmt.Errorf("invalid CycloneDX XML: %w", err)
+	}
+	return bom.flatten(), nil
+}
+
+// flatten returns the metadata component and all nested components in document order
+func (b cdxBOM) flatten() []Component {
+	var components []Component
+
+	var walk func(cs []cdxComponent)
+	walk = func(cs []cdxComponent) {
+		for _, c := range cs {
+			components = append(components, withPURLVersion(Component{
+				Name:    c.Name,
+				Version: c.Version,
+				PURL:    c.PURL,
+				Ref:     c.BOMRef,
+			}))
+			walk(c.Components)
+		}
+	}
+
+	if b.Metadata.Component != nil {
+		walk([]cdxComponent{*b.Metadata.Component})
+	}
+	walk(b.Components)
+
+	return components
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// Component is a software component listed in an SBOM
+type Component struct {
+	// Name is the component name as given in the SBOM
+	Name string
+	// Version is the component version, taken from the purl if the SBOM omits it
+	Version string
+	// PURL is the package URL, e.g. "pkg:npm/%40angular/core@17.3.0"
+	PURL string
+	// Ref is the identifier of the component within the SBOM (bom-ref or SPDXID)
+	Ref string
+}
+
+// ErrUnknownFormat is returned for documents that are neither CycloneDX nor SPDX
+var ErrUnknownFormat = errors.New("unknown SBOM format (expected CycloneDX JSON/XML or SPDX JSON/tag-value)")
+
+// Load reads the SBOM at path
+func Load(path string) ([]Component, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SBOM: %w", err)
+	}
+
+	components, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SBOM %s: %w", path, err)
+	}
+	return components, nil
+}
+
+// Parse detects the format of an SBOM document and returns its components
+func Parse(data []byte) ([]Component, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return parseCycloneDXXML(trimmed)
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		var probe struct {
+			BOMFormat   string `json:"bomFormat"`
+			SPDXVersion string `json:"spdxVersion"`
+		}
+		if err := json.Unmarshal(trimmed, &probe); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		switch {
+		case probe.BOMFormat == "CycloneDX":
+			return parseCycloneDXJSON(trimmed)
+		case probe.SPDXVersion != "":
+			return parseSPDXJSON(trimmed)
+		}
+	case bytes.Contains(trimmed, []byte("SPDXVersion:")):
+		return parseSPDXTagValue(trimmed), nil
+	}
+
+	return nil, ErrUnknownFormat
+}
+
+// purl is the subset of a package URL needed for product mapping
+type purl struct {
+	Type      string
+	Namespace string
+	Name      string
+	Version   string
+}
+
+// parsePURL parses a package URL like "pkg:maven/org.springframework/spring-core@5.3.30"
+func parsePURL(s string) (purl, bool) {
+	rest, ok := strings.CutPrefix(s, "pkg:")
+	if !ok {
+		return purl{}, false
+	}
+	if i := strings.IndexAny(rest, "?#"); i >= 0 {
+		rest = rest[:i]
+	}
+
+	var p purl
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		p.Version, _ = url.PathUnescape(rest[i+1:])
+		rest = rest[:i]
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(segments) < 2 {
+		return purl{}, false
+	}
+	for i, seg := range segments {
+		if unescaped, err := url.PathUnescape(seg); err == nil {
+			segments[i] = unescaped
+		}
+	}
+
+	p.Type = strings.ToLower(segments[0])
+	p.Name = segments[len(segments)-1]
+	p.Namespace = strings.Join(segments[1:len(segments)-1], "/")
+
+	return p, true
+}
+
+// purlProducts maps "type/namespace/name" of well-known packages to endoflife.date products
+var purlProducts = map[string]string{
+	"composer/laravel/framework":                "laravel",
+	"composer/symfony/symfony":                  "symfony",
+	"composer/symfony/http-kernel":              "symfony",
+	"docker/golang":                             "go",
+	"docker/node":                               "nodejs",
+	"docker/postgres":                           "postgresql",
+	"gem/rails":                                 "rails",
+	"gem/railties":                              "rails",
+	"golang/stdlib":                             "go",
+	"maven/log4j/log4j":                         "log4j",
+	"maven/org.apache.logging.log4j/log4j-core": "log4j",
+	"maven/org.apache.tomcat.embed/tomcat-embed-core": "tomcat",
+	"maven/org.springframework/spring-core":           "spring-framework",
+	"maven/org.springframework.boot/spring-boot":      "spring-boot",
+	"npm/@angular/core":                               "angular",
+	"npm/electron":                                    "electron",
+	"npm/jquery":                                      "jquery",
+	"npm/node":                                        "nodejs",
+	"npm/react":                                       "react",
+	"npm/vue":                                         "vue",
+	"nuget/microsoft.aspnetcore.app":                  "dotnet",
+	"nuget/microsoft.netcore.app":                     "dotnet",
+	"pypi/django":                                     "django",
+	"pypi/numpy":                                      "numpy",
+}
+
+// systemTypes are purl types whose package names usually equal product names
+var systemTypes = map[string]bool{
+	"alpm":    true,
+	"apk":     true,
+	"deb":     true,
+	"docker":  true,
+	"generic": true,
+	"oci":     true,
+	"rpm":     true,
+}
+
+// Product returns the endoflife.date product name candidate of the component.
+// Library packages are only mapped through a curated table, because a name
+// like "pkg:pypi/redis" denotes a client library rather than the server.
+func (c Component) Product() (string, bool) {
+	p, ok := parsePURL(c.PURL)
+	if !ok {
+		return "", false
+	}
+
+	// Official Docker images live in the "library" namespace
+	if p.Type == "docker" && p.Namespace == "library" {
+		p.Namespace = ""
+	}
+
+	key := p.Type + "/" + p.Name
+	if p.Namespace != "" {
+		key = p.Type + "/" + p.Namespace + "/" + p.Name
+	}
+	if product, ok := purlProducts[strings.ToLower(key)]; ok {
+		return product, true
+	}
+
+	if systemTypes[p.Type] {
+		return strings.ToLower(p.Name), true
+	}
+	return "", false
+}
+
+// withPURLVersion fills in a missing version from the component's purl
+func withPURLVersion(c Component) Component {
+	if c.Version == "" {
+		if p, ok := parsePURL(c.PURL); ok {
+			c.Version = p.Version
+		}
+	}
+	return c
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package sbom
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		file string
+		want []Component
+	}{
+		{
+			file: "testdata/cyclonedx.json",
+			want: []Component{
+				{Name: "shop", Version: "2.1.0", Ref: "app"},
+				{Name: "core", Version: "15.2.10", PURL: "pkg:npm/%40angular/core@15.2.10", Ref: "pkg:npm/%40angular/core@15.2.10"},
+				{Name: "spring-boot", Version: "2.7.18", PURL: "pkg:maven/org.springframework.boot/spring-boot@2.7.18?type=jar", Ref: "spring"},
+				{Name: "spring-core", Version: "5.3.31", PURL: "pkg:maven/org.springframework/spring-core@5.3.31"},
+			},
+		},
+		{
+			file: "testdata/cyclonedx.xml",
+			want: []Component{
+				{Name: "python", Version: "3.8.18-slim", PURL: "pkg:docker/library/python@3.8.18-slim", Ref: "python-image"},
+				{Name: "openssl", Version: "3.0.11-1~deb12u2", PURL: "pkg:deb/debian/openssl@3.0.11-1~deb12u2?arch=amd64"},
+				{Name: "redis", Version: "5.0.1", PURL: "pkg:pypi/redis@5.0.1"},
+			},
+		},
+		{
+			file: "testdata/spdx.json",
+			want: []Component{
+				{Name: "Django", Version: "3.2.25", PURL: "pkg:pypi/django@3.2.25", Ref: "SPDXRef-Package-django"},
+				{Name: "stdlib", Version: "go1.21.4", PURL: "pkg:golang/stdlib@go1.21.4", Ref: "SPDXRef-Package-go"},
+			},
+		},
+		{
+			file: "testdata/spdx.spdx",
+			want: []Component{
+				{Name: "node", Version: "16.20.2", PURL: "pkg:npm/node@16.20.2", Ref: "SPDXRef-Package-node"},
+				{Name: "left-pad", Version: "1.3.0", PURL: "pkg:npm/left-pad@1.3.0", Ref: "SPDXRef-Package-left-pad"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got, err := Load(tt.file)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	for _, data := range []string{"", `{"name": "not an sbom"}`, "just text"} {
+		if _, err := Parse([]byte(data)); !errors.Is(err, ErrUnknownFormat) {
+			t.Errorf("Parse(%q) error = %v, want ErrUnknownFormat", data, err)
+		}
+	}
+}
+
+func TestComponentProduct(t *testing.T) {
+	tests := []struct {
+		purl   string
+		want   string
+		wantOK bool
+	}{
+		{purl: "pkg:npm/%40angular/core@15.2.10", want: "angular", wantOK: true},
+		{purl: "pkg:maven/org.springframework/spring-core@5.3.31", want: "spring-framework", wantOK: true},
+		{purl: "pkg:golang/stdlib@go1.21.4", want: "go", wantOK: true},
+		{purl: "pkg:pypi/Django@3.2", want: "django", wantOK: true},
+		{purl: "pkg:docker/library/node@20", want: "nodejs", wantOK: true},
+		{purl: "pkg:docker/library/python@3.8.18-slim", want: "python", wantOK: true},
+		{purl: "pkg:deb/debian/openssl@3.0.11-1~deb12u2?arch=amd64", want: "openssl", wantOK: true},
+		{purl: "pkg:pypi/redis@5.0.1", wantOK: false},
+		{purl: "pkg:npm/left-pad@1.3.0", wantOK: false},
+		{purl: "", wantOK: false},
+		{purl: "not-a-purl", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			got, ok := Component{PURL: tt.purl}.Product()
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Product() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// spdxDocument is the part of an SPDX JSON document that lists packages
+type spdxDocument struct {
+	Packages []struct {
+		SPDXID       string `json:"SPDXID"`
+		Name         string `json:"name"`
+		VersionInfo  string `json:"versionInfo"`
+		ExternalRefs []struct {
+			ReferenceType    string `json:"referenceType"`
+			ReferenceLocator string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+	} `json:"packages"`
+}
+
+func parseSPDXJSON(data []byte) ([]Component, error) {
+	var doc spdxDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid SPDX JSON: %w", err)
+	}
+
+	components := make([]Component, 0, len(doc.Packages))
+	for _, p := range doc.Packages {
+		c := Component{Name: p.Name, Version: p.VersionInfo, Ref: p.SPDXID}
+		for _, ref := range p.ExternalRefs {
+			if ref.ReferenceType == "purl" {
+				c.PURL = ref.ReferenceLocator
+				break
+			}
+		}
+		components = append(components, withPURLVersion(c))
+	}
+	return components, nil
+}
+
+// parseSPDXTagValue parses the package sections of an SPDX tag-value document
+func parseSPDXTagValue(data []byte) []Component {
+	var (
+		components []Component
+		current    *Component
+	)
+	flush := func() {
+		if current != nil {
+			components = append(components, withPURLVersion(*current))
+			current = nil
+		}
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		tag, value, ok := strings.Cut(strings.TrimSpace(s.Text()), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch tag {
+		case "PackageName":
+			flush()
+			current = &Component{Name: value}
+		case "FileName", "SnippetSPDXID":
+			// A file or snippet section ends the current package
+			flush()
+		case "SPDXID":
+			if current != nil && current.Ref == "" {
+				current.Ref = value
+			}
+		case "PackageVersion":
+			if current != nil {
+				current.Version = value
+			}
+		case "ExternalRef":
+			// ExternalRef: PACKAGE-MANAGER purl pkg:npm/react@18.2.0
+			if fields := strings.Fields(value); current != nil && current.PURL == "" &&
+				len(fields) == 3 && fields[1] == "purl" {
+				current.PURL = fields[2]
+			}
+		}
+	}
+	flush()
+
+	return components
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Finding is a runtime or dependency version detected in a project
+type Finding struct {
+	// Product is the endoflife.date product name the finding maps to
+	Product string
+	// Version is the detected version or version constraint
+	Version string
+	// Source is the file (relative to the scanned directory) and line it was found in
+	Source string
+}
+
+// detector extracts findings from the contents of a file
+type detector func(data []byte) []Finding
+
+// detectors maps file names to the detector handling them
+var detectors = map[string]detector{
+	"go.mod":              detectGoMod,
+	".nvmrc":              versionFile("nodejs"),
+	".node-version":       versionFile("nodejs"),
+	".python-version":     versionFile("python"),
+	".ruby-version":       versionFile("ruby"),
+	".tool-versions":      detectToolVersions,
+	"package.json":        detectPackageJSON,
+	"pyproject.toml":      detectPyproject,
+	"Dockerfile":          detectDockerfile,
+	"docker-compose.yml":  detectCompose,
+	"docker-compose.yaml": detectCompose,
+	"compose.yml":         detectCompose,
+	"compose.yaml":        detectCompose,
+}
+
+// skipDirs are never descended into
+var skipDirs = map[string]bool{
+	".git":         true,
+	".hg":          true,
+	".venv":        true,
+	"venv":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"__pycache__":  true,
+}
+
+// toolProducts maps asdf/mise tool names to endoflife.date products
+var toolProducts = map[string]string{
+	"golang": "go",
+	"node":   "nodejs",
+	"java":   "java",
+	"dotnet": "dotnet",
+}
+
+// imageProducts maps Docker image names to endoflife.date products
+var imageProducts = map[string]string{
+	"node":                             "nodejs",
+	"golang":                           "go",
+	"postgres":                         "postgresql",
+	"mongo":                            "mongodb",
+	"httpd":                            "apache-http-server",
+	"openjdk":                          "java",
+	"amazoncorretto":                   "amazon-corretto",
+	"eclipse-temurin":                  "eclipse-temurin",
+	"elasticsearch":                    "elasticsearch",
+	"mcr.microsoft.com/dotnet/aspnet":  "dotnet",
+	"mcr.microsoft.com/dotnet/runtime": "dotnet",
+	"mcr.microsoft.com/dotnet/sdk":     "dotnet",
+}
+
+// Scan walks dir in lexical order and returns all detected runtime and
+// dependency versions
+func Scan(dir string) ([]Finding, error) {
+	var findings []Finding
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && skipDirs[d.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		detect := detectorFor(d.Name())
+		if detect == nil {
+			return nil
+		}
+
+		data, err := os.ReadFile(path) //nolint:gosec // scanning user-provided directories is the purpose
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			rel = path
+		}
+		for _, f := range detect(data) {
+			if f.Source == "" {
+				f.Source = rel
+			} else {
+				f.Source = rel + ":" + f.Source
+			}
+			findings = append(findings, f)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", dir, err)
+	}
+
+	return findings, nil
+}
+
+// detectorFor returns the detector for a file name, if any
+func detectorFor(name string) detector {
+	if d, ok := detectors[name]; ok {
+		return d
+	}
+	// Dockerfile variants such as Dockerfile.prod or app.Dockerfile
+	if strings.HasPrefix(name, "Dockerfile.") || strings.HasSuffix(name, ".Dockerfile") {
+		return detectDockerfile
+	}
+	return nil
+}
+
+// lines calls fn for each trimmed, non-empty, non-comment line with its number
+func lines(data []byte, fn func(n int, line string)) {
+	s := bufio.NewScanner(bytes.NewReader(data))
+	n := 0
+	for s.Scan() {
+		n++
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fn(n, line)
+	}
+}
+
+// hasDigit reports whether s contains a digit
+func hasDigit(s string) bool {
+	return strings.ContainsAny(s, "0123456789")
+}
+
+// versionFile returns a detector for single-version files like .nvmrc
+func versionFile(product string) detector {
+	return func(data []byte) []Finding {
+		var findings []Finding
+		lines(data, func(n int, line string) {
+			// rbenv/pyenv allow "ruby-3.2.2" style prefixes
+			line = strings.TrimPrefix(line, product+"-")
+			// Skips aliases like "lts/iron" or "system" and other implementations like "pypy3.10"
+			if v := strings.TrimPrefix(line, "v"); v == "" || !hasDigit(v[:1]) || strings.Contains(v, "/") {
+				return
+			}
+			findings = append(findings, Finding{Product: product, Version: line, Source: fmt.Sprint(n)})
+		})
+		return findings
+	}
+}
+
+// goDirective matches the go directive of a go.mod file
+var goDirective = regexp.MustCompile(`^go\s+(\d+(?:\.\d+)*)`)
+
+func detectGoMod(data []byte) []Finding {
+	var findings []Finding
+	lines(data, func(n int, line string) {
+		if m := goDirective.FindStringSubmatch(line); m != nil {
+			findings = append(findings, Finding{Product: "go", Version: m[1], Source: fmt.Sprint(n)})
+		}
+	})
+	return findings
+}
+
+func detectToolVersions(data []byte) []Finding {
+	var findings []Finding
+	lines(data, func(n int, line string) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !hasDigit(fields[1]) {
+			return
+		}
+		product := fields[0]
+		if p, ok := toolProducts[product]; ok {
+			product = p
+		}
+		findings = append(findings, Finding{Product: product, Version: fields[1], Source: fmt.Sprint(n)})
+	})
+	return findings
+}
+
+// constraintVersion matches the first version number in a constraint like ">=18.0.0 <21"
+var constraintVersion = regexp.MustCompile(`\d+(?:\.\d+)*`)
+
+func detectPackageJSON(data []byte) []Finding {
+	var pkg struct {
+		Engines map[string]string `json:"engines"`
+	}
+	if json.Unmarshal(data, &pkg) != nil {
+		return nil
+	}
+
+	if v := constraintVersion.FindString(pkg.Engines["node"]); v != "" {
+		return []Finding{{Product: "nodejs", Version: v, Source: "engines.node"}}
+	}
+	return nil
+}
+
+// requiresPython matches PEP 621 requires-python and Poetry's python dependency
+var requiresPython = regexp.MustCompile(`^(?:requires-python|python)\s*=\s*["']([^"']+)["']`)
+
+func detectPyproject(data []byte) []Finding {
+	var findings []Finding
+	lines(data, func(n int, line string) {
+		m := requiresPython.FindStringSubmatch(line)
+		if m == nil {
+			return
+		}
+		if v := constraintVersion.FindString(m[1]); v != "" {
+			findings = append(findings, Finding{Product: "python", Version: v, Source: fmt.Sprint(n)})
+		}
+	})
+	return findings
+}
+
+// fromLine matches a Dockerfile FROM instruction, skipping flags like --platform
+var fromLine = regexp.MustCompile(`(?i)^FROM\s+(?:--\S+\s+)*(\S+)`)
+
+func detectDockerfile(data []byte) []Finding {
+	var findings []Finding
+	lines(data, func(n int, line string) {
+		if m := fromLine.FindStringSubmatch(line); m != nil {
+			if f, ok := imageFinding(m[1]); ok {
+				f.Source = fmt.Sprint(n)
+				findings = append(findings, f)
+			}
+		}
+	})
+	return findings
+}
+
+// imageLine matches the image key of a compose service
+var imageLine = regexp.MustCompile(`^image:\s*["']?([^"'\s]+)`)
+
+func detectCompose(data []byte) []Finding {
+	var findings []Finding
+	lines(data, func(n int, line string) {
+		if m := imageLine.FindStringSubmatch(line); m != nil {
+			if f, ok := imageFinding(m[1]); ok {
+				f.Source = fmt.Sprint(n)
+				findings = append(findings, f)
+			}
+		}
+	})
+	return findings
+}
+
+// imageFinding maps an image reference like "docker.io/library/python:3.12-slim"
+// to a finding. Images without a numeric tag or with build arguments are skipped.
+func imageFinding(ref string) (Finding, bool) {
+	if i := strings.Index(ref, "@"); i >= 0 {
+		ref = ref[:i]
+	}
+	if strings.Contains(ref, "$") {
+		return Finding{}, false
+	}
+
+	// The tag separator is the last colon after the last slash (registries may have ports)
+	name, tag := ref, ""
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		name, tag = ref[:i], ref[i+1:]
+	}
+	if !hasDigit(tag) {
+		return Finding{}, false
+	}
+
+	if p, ok := imageProducts[name]; ok {
+		return Finding{Product: p, Version: tag}, true
+	}
+
+	// Strip the registry and the official library namespace
+	name = strings.TrimPrefix(name, "docker.io/")
+	name = strings.TrimPrefix(name, "library/")
+	if strings.Contains(name, "/") {
+		// Third-party images rarely map to endoflife.date products
+		return Finding{}, false
+	}
+	if p, ok := imageProducts[name]; ok {
+		name = p
+	}
+
+	return Finding{Product: name, Version: tag}, true
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package scan
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	got, err := Scan("testdata/project")
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	want := []Finding{
+		{Product: "python", Version: "3.11.4", Source: ".python-version:1"},
+		{Product: "go", Version: "1.22.3", Source: ".tool-versions:2"},
+		{Product: "nodejs", Version: "20.11.0", Source: ".tool-versions:3"},
+		{Product: "terraform", Version: "1.7.5", Source: ".tool-versions:4"},
+		{Product: "ruby", Version: "3.2.2", Source: "api/.ruby-version:1"},
+		{Product: "python", Version: "3.12-slim", Source: "api/Dockerfile:2"},
+		{Product: "python", Version: "3.10", Source: "api/pyproject.toml:3"},
+		{Product: "python", Version: "3.11", Source: "api/pyproject.toml:6"},
+		{Product: "postgresql", Version: "15.4", Source: "deploy/docker-compose.yml:3"},
+		{Product: "redis", Version: "7-alpine", Source: "deploy/docker-compose.yml:5"},
+		{Product: "nginx", Version: "1.25", Source: "deploy/docker-compose.yml:7"},
+		{Product: "go", Version: "1.22.3", Source: "go.mod:3"},
+		{Product: "nodejs", Version: "v20.11.0", Source: "web/.nvmrc:1"},
+		{Product: "nodejs", Version: "18.17.0", Source: "web/package.json:engines.node"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() returned %d findings:", len(got))
+		for _, f := range got {
+			t.Errorf("  %+v", f)
+		}
+	}
+}
+
+func TestScan_MissingDir(t *testing.T) {
+	if _, err := Scan("testdata/missing"); err == nil {
+		t.Error("Scan() of missing directory returned no error")
+	}
+}
+
+func TestImageFinding(t *testing.T) {
+	tests := []struct {
+		ref    string
+		want   Finding
+		wantOK bool
+	}{
+		{ref: "python:3.12", want: Finding{Product: "python", Version: "3.12"}, wantOK: true},
+		{ref: "node:20-alpine", want: Finding{Product: "nodejs", Version: "20-alpine"}, wantOK: true},
+		{ref: "library/golang:1.22", want: Finding{Product: "go", Version: "1.22"}, wantOK: true},
+		{ref: "mcr.microsoft.com/dotnet/sdk:8.0", want: Finding{Product: "dotnet", Version: "8.0"}, wantOK: true},
+		{ref: "ubuntu:22.04", want: Finding{Product: "ubuntu", Version: "22.04"}, wantOK: true},
+		{ref: "debian:bookworm", wantOK: false},
+		{ref: "python", wantOK: false},
+		{ref: "python:${VERSION}", wantOK: false},
+		{ref: "ghcr.io/org/app:1.0", wantOK: false},
+		{ref: "localhost:5000/redis", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, ok := imageFinding(tt.ref)
+			if ok != tt.wantOK {
+				t.Fatalf("imageFinding(%q) ok = %v, want %v", tt.ref, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Errorf("imageFinding(%q) = %+v, want %+v", tt.ref, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionFile(t *testing.T) {
+	detect := versionFile("python")
+
+	got := detect([]byte("# pyenv\n3.12.1\npypy3.10\nsystem\nv\nvNext\n\n3.11\n"))
+	want := []Finding{
+		{Product: "python", Version: "3.12.1", Source: "2"},
+		{Product: "python", Version: "3.11", Source: "8"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("versionFile() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,5 @@
+module example.com/project
+
+go 1.22.3
+
+require golang.org/x/text v0.14.0
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package search
+
+// Aliases maps alternative names, e.g. "k8s", to product names. Keys are
+// lowercase with hyphens as separators.
+type Aliases map[string]string
+
+// BuiltinAliases are common nicknames of products. Aliases whose product is
+// not in the product list are ignored, so the table may name products that
+// only some API versions or mirrors provide.
+var BuiltinAliases = Aliases{
+	".net":                     "dotnet",
+	"al2":                      "amazon-linux",
+	"aks":                      "azure-kubernetes-service",
+	"alpine-linux":             "alpine",
+	"apache":                   "apache-http-server",
+	"dotnet-core":              "dotnet",
+	"eks":                      "amazon-eks",
+	"elastic":                  "elasticsearch",
+	"gke":                      "google-kubernetes-engine",
+	"golang":                   "go",
+	"httpd":                    "apache-http-server",
+	"ie":                       "internet-explorer",
+	"k8s":                      "kubernetes",
+	"kafka":                    "apache-kafka",
+	"kube":                     "kubernetes",
+	"mac":                      "macos",
+	"maria":                    "mariadb",
+	"mongo":                    "mongodb",
+	"mssql":                    "mssqlserver",
+	"node":                     "nodejs",
+	"node.js":                  "nodejs",
+	"osx":                      "macos",
+	"pg":                       "postgresql",
+	"postgres":                 "postgresql",
+	"py":                       "python",
+	"python3":                  "python",
+	"rabbit":                   "rabbitmq",
+	"red-hat":                  "rhel",
+	"red-hat-enterprise-linux": "rhel",
+	"redhat":                   "rhel",
+	"ror":                      "rails",
+	"ruby-on-rails":            "rails",
+	"spring":                   "spring-framework",
+	"springboot":               "spring-boot",
+	"sql-server":               "mssqlserver",
+	"tf":                       "terraform",
+	"win":                      "windows",
+	"win-server":               "windows-server",
+}
+
+// With returns a copy of a extended by other; entries of other take
+// precedence
+func (a Aliases) With(other map[string]string) Aliases {
+	merged := make(Aliases, len(a)+len(other))
+	for alias, product := range a {
+		merged[alias] = product
+	}
+	for alias, product := range other {
+		merged[normalize(alias)] = product
+	}
+	return merged
+}
+
+// Resolve returns the product named by query: a case-insensitive exact
+// match of a product name, or else the product of a known alias. Product
+// names win over aliases, and aliases of products missing from products
+// are ignored.
+func Resolve(products []string, aliases Aliases, query string) (string, bool) {
+	if product, found := FindExact(products, query); found {
+		return product, true
+	}
+	if target, ok := aliases[normalize(query)]; ok {
+		return FindExact(products, target)
+	}
+	return "", false
+}
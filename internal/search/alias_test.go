@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package search
+
+import "testing"
+
+func TestResolve(t *testing.T) {
+	products := []string{"kubernetes", "nodejs", "postgresql", "python", "rhel", "windows", "dotnet", "go", "k8s-dashboard"}
+	aliases := BuiltinAliases.With(map[string]string{
+		"Red Hat":   "rhel",
+		"my-db":     "postgresql",
+		"dashboard": "k8s-dashboard",
+		"pg":        "postgres-ng",
+	})
+
+	tests := []struct {
+		name      string
+		query     string
+		wantMatch string
+		wantFound bool
+	}{
+		{"product name", "python", "python", true},
+		{"product name case insensitive", "NodeJS", "nodejs", true},
+		{"builtin alias", "k8s", "kubernetes", true},
+		{"builtin alias case insensitive", "Node", "nodejs", true},
+		{"builtin alias postgres", "postgres", "postgresql", true},
+		{"builtin alias py", "py", "python", true},
+		{"builtin alias win", "win", "windows", true},
+		{"builtin alias dotnet", ".NET", "dotnet", true},
+		{"extra alias with spaces", "red hat", "rhel", true},
+		{"extra alias", "my-db", "postgresql", true},
+		{"extra alias overrides builtin", "pg", "", false},
+		{"alias of missing product", "tf", "", false},
+		{"unknown", "cobol", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := Resolve(products, aliases, tt.query)
+			if found != tt.wantFound || got != tt.wantMatch {
+				t.Errorf("Resolve(%q) = %q, %v; want %q, %v", tt.query, got, found, tt.wantMatch, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestResolve_ProductNameWinsOverAlias(t *testing.T) {
+	products := []string{"node", "nodejs"}
+
+	if got, _ := Resolve(products, BuiltinAliases, "node"); got != "node" {
+		t.Errorf("Resolve(node) = %q, want the product node", got)
+	}
+}
+
+func TestAliases_WithDoesNotModify(t *testing.T) {
+	base := Aliases{"k8s": "kubernetes"}
+	_ = base.With(map[string]string{"k8s": "k3s"})
+
+	if base["k8s"] != "kubernetes" {
+		t.Errorf("With() modified the receiver: %v", base)
+	}
+}
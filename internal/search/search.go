@@ -4,10 +4,35 @@
 package search
 
 import (
-	"sort"
+	"slices"
 	"strings"
 )
 
+// Scores of the match kinds, best first. Penalties for extra characters and
+// typos are subtracted, so the kinds overlap only for poor matches.
+const (
+	scoreExact       = 1000
+	scoreCompact     = 950 // equal when separators are ignored, e.g. "amazon linux"
+	scorePrefix      = 900
+	scoreToken       = 800 // equals a hyphen-separated part of the name
+	scoreTokenPrefix = 700
+	scoreSubstring   = 600
+	scoreTypo        = 500 // within a few edits of the name or one of its parts
+	scoreTypoPrefix  = 400 // within a few edits of the beginning of the name
+
+	// editPenalty is subtracted per edit of a typo match
+	editPenalty = 100
+	// maxPenalty caps the penalty for extra characters and the match position
+	maxPenalty = 99
+)
+
+// Match is a product found by FindSimilar
+type Match struct {
+	Product string
+	// Score rates the match; higher is better
+	Score int
+}
+
 // FindExact performs a case-insensitive exact match search
 func FindExact(products []string, query string) (string, bool) {
 	queryLower := strings.ToLower(query)
@@ -19,18 +44,26 @@ func FindExact(products []string, query string) (string, bool) {
 	return "", false
 }
 
-// FindSimilar returns products that contain the query as substring (case-insensitive)
-func FindSimilar(products []string, query string, limit int) []string {
-	queryLower := strings.ToLower(query)
+// FindSimilar returns up to limit products matching query, best first. Exact
+// matches rank before prefixes, parts of hyphenated names and substrings;
+// names within a few typos of the query are found as well. Ties are sorted
+// alphabetically. An empty query matches all products.
+func FindSimilar(products []string, query string, limit int) []Match {
+	q := normalize(query)
 
-	var results []string
+	var results []Match
 	for _, p := range products {
-		if strings.Contains(strings.ToLower(p), queryLower) {
-			results = append(results, p)
+		if score, ok := scoreMatch(strings.ToLower(p), q); ok {
+			results = append(results, Match{Product: p, Score: score})
 		}
 	}
 
-	sort.Strings(results)
+	slices.SortFunc(results, func(a, b Match) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		return strings.Compare(a.Product, b.Product)
+	})
 
 	if len(results) > limit {
 		results = results[:limit]
@@ -38,3 +71,146 @@ func FindSimilar(products []string, query string, limit int) []string {
 
 	return results
 }
+
+// Names returns the product names of matches
+func Names(matches []Match) []string {
+	if matches == nil {
+		return nil
+	}
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.Product
+	}
+	return names
+}
+
+// normalize lowercases the query and writes spaces and underscores as
+// hyphens, the separator used in product names
+func normalize(query string) string {
+	return strings.NewReplacer(" ", "-", "_", "-").Replace(strings.ToLower(strings.TrimSpace(query)))
+}
+
+// isSeparator reports whether r separates the parts of a product name
+func isSeparator(r rune) bool {
+	return r == '-' || r == '_' || r == '.' || r == ' '
+}
+
+// compact removes the separators from s
+func compact(s string) string {
+	return strings.Join(strings.FieldsFunc(s, isSeparator), "")
+}
+
+// scoreMatch rates how well the lowercase name matches the normalized query
+func scoreMatch(name, query string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+
+	extra := len(name) - len(query)
+	switch {
+	case name == query:
+		return scoreExact, true
+	case compact(name) == compact(query):
+		return scoreCompact, true
+	case strings.HasPrefix(name, query):
+		return scorePrefix - penalty(extra), true
+	}
+
+	tokens := strings.FieldsFunc(name, isSeparator)
+	for _, t := range tokens {
+		if t == query {
+			return scoreToken - penalty(extra), true
+		}
+	}
+	for _, t := range tokens {
+		if strings.HasPrefix(t, query) {
+			return scoreTokenPrefix - penalty(extra), true
+		}
+	}
+	if i := strings.Index(name, query); i >= 0 {
+		return scoreSubstring - penalty(i+extra), true
+	}
+
+	maxEdits := allowedEdits(query)
+	if maxEdits == 0 {
+		return 0, false
+	}
+
+	best := distance(query, name)
+	for _, t := range tokens {
+		best = min(best, distance(query, t))
+	}
+	if best <= maxEdits {
+		return scoreTypo - best*editPenalty - penalty(abs(extra)), true
+	}
+
+	// Typos in a name that is not typed completely, e.g. "postgress"
+	// for "postgresql"
+	best = maxEdits + 1
+	for n := len(query) - 1; n <= len(query)+1; n++ {
+		if n > 0 && n < len(name) {
+			best = min(best, distance(query, name[:n]))
+		}
+	}
+	if best <= maxEdits {
+		return scoreTypoPrefix - best*editPenalty - penalty(extra), true
+	}
+
+	return 0, false
+}
+
+// allowedEdits returns how many typos a query of this length may contain;
+// short queries must match literally
+func allowedEdits(query string) int {
+	switch {
+	case len(query) <= 3:
+		return 0
+	case len(query) <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// penalty caps n at maxPenalty
+func penalty(n int) int {
+	return min(n, maxPenalty)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// distance returns the edit distance of a and b, counting insertions,
+// deletions, substitutions and transpositions of adjacent characters
+func distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+
+	// Three rows of the distance matrix are needed for transpositions
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	return prev[len(t)]
+}
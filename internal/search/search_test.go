@@ -87,7 +87,7 @@ func TestFindSimilar(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := FindSimilar(products, tt.query, tt.limit)
+			got := Names(FindSimilar(products, tt.query, tt.limit))
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("FindSimilar() = %v, want %v", got, tt.want)
 			}
@@ -98,10 +98,124 @@ func TestFindSimilar(t *testing.T) {
 func TestFindSimilar_Sorted(t *testing.T) {
 	products := []string{"zython", "python", "aython"}
 
-	got := FindSimilar(products, "ython", 10)
+	got := Names(FindSimilar(products, "ython", 10))
 	want := []string{"aython", "python", "zython"}
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("FindSimilar() results not sorted: got %v, want %v", got, want)
 	}
 }
+
+func TestFindSimilar_Ranking(t *testing.T) {
+	products := []string{
+		"amazon-linux", "aws-lambda", "django", "go", "golang", "google-kubernetes-engine",
+		"mongo", "nodejs", "postgres", "postgresql", "python", "ruby",
+	}
+
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "exact match first",
+			query: "go",
+			limit: 10,
+			want:  []string{"go", "golang", "google-kubernetes-engine", "mongo", "django"},
+		},
+		{
+			name:  "typo",
+			query: "nodjs",
+			limit: 10,
+			want:  []string{"nodejs"},
+		},
+		{
+			name:  "typo in a partial name",
+			query: "postgress",
+			limit: 10,
+			want:  []string{"postgres", "postgresql"},
+		},
+		{
+			name:  "transposed letters",
+			query: "pyhton",
+			limit: 10,
+			want:  []string{"python"},
+		},
+		{
+			name:  "part of a hyphenated name",
+			query: "lambda",
+			limit: 10,
+			want:  []string{"aws-lambda"},
+		},
+		{
+			name:  "typo in part of a hyphenated name",
+			query: "lamda",
+			limit: 10,
+			want:  []string{"aws-lambda"},
+		},
+		{
+			name:  "spaces instead of hyphens",
+			query: "Amazon Linux",
+			limit: 10,
+			want:  []string{"amazon-linux"},
+		},
+		{
+			name:  "short queries need a literal match",
+			query: "rbu",
+			limit: 10,
+			want:  nil,
+		},
+		{
+			name:  "limit keeps the best matches",
+			query: "go",
+			limit: 2,
+			want:  []string{"go", "golang"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Names(FindSimilar(products, tt.query, tt.limit))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindSimilar(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindSimilar_Scores(t *testing.T) {
+	got := FindSimilar([]string{"python", "python3", "pyhton"}, "python", 10)
+
+	if len(got) != 3 {
+		t.Fatalf("FindSimilar() = %v, want 3 matches", got)
+	}
+	if got[0].Product != "python" || got[0].Score != scoreExact {
+		t.Errorf("first match = %+v, want python with score %d", got[0], scoreExact)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Score > got[i-1].Score {
+			t.Errorf("scores not descending: %v", got)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"go", "go", 0},
+		{"", "abc", 3},
+		{"nodjs", "nodejs", 1},
+		{"pyhton", "python", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tt := range tests {
+		if got := distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
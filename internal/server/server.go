@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/search"
+	"github.com/oliverandrich/eol-date/internal/ui"
+)
+
+// errUnknownProduct is returned for product names not in the product list
+var errUnknownProduct = errors.New("unknown product")
+
+// Server serves the endoflife.date data as a JSON API and an HTML dashboard.
+// Upstream responses are kept in memory for the TTL, so the API is contacted
+// at most once per TTL and product regardless of traffic.
+type Server struct {
+	client *api.Client
+	// now returns the current time; replaced in tests
+	now      api.Clock
+	aliases  search.Aliases
+	entries  map[string]*entry
+	ttl      time.Duration
+	warnDays int
+	mu       sync.Mutex
+}
+
+// errorTTL is how long a failed upstream request is remembered before it is
+// retried, so an unreachable API is not hit by every incoming request
+const errorTTL = 30 * time.Second
+
+// entry is a memoized upstream response. Its mutex is held while fetching,
+// so concurrent requests for the same path wait for a single fetch.
+type entry struct {
+	fetched time.Time
+	// err is the error of the last fetch; data from an earlier successful
+	// fetch is served in its place
+	err      error
+	products []string
+	cycles   []api.Cycle
+	mu       sync.Mutex
+	ok       bool
+}
+
+// result returns the error to report for e: none while it holds data of a
+// successful fetch, even if a later one failed
+func (e *entry) result() error {
+	if e.ok {
+		return nil
+	}
+	return e.err
+}
+
+// New creates a server that fetches data through client and keeps it for
+// ttl. Product names are resolved with aliases; cycles ending within
+// warnDays are reported as expiring.
+func New(client *api.Client, aliases search.Aliases, ttl time.Duration, warnDays int) *Server {
+	return &Server{
+		client:   client,
+		aliases:  aliases,
+		ttl:      ttl,
+		warnDays: warnDays,
+		now:      api.SystemClock,
+		entries:  make(map[string]*entry),
+	}
+}
+
+// Handler returns the HTTP handler with all routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleIndex)
+	mux.HandleFunc("GET /products/{name}", s.handleProductPage)
+	mux.HandleFunc("GET /api/products", s.handleProducts)
+	mux.HandleFunc("GET /api/products/{name}", s.handleProduct)
+	return mux
+}
+
+// entryFor returns the memo entry for key, creating it if necessary
+func (s *Server) entryFor(key string) *entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &entry{}
+		s.entries[key] = e
+	}
+	return e
+}
+
+// fresh reports whether e need not be fetched again: its last fetch
+// succeeded within the TTL or failed within errorTTL
+func (s *Server) fresh(e *entry) bool {
+	if e.fetched.IsZero() {
+		return false
+	}
+	ttl := s.ttl
+	if e.err != nil {
+		ttl = min(ttl, errorTTL)
+	}
+	return s.now().Sub(e.fetched) < ttl
+}
+
+// products returns the memoized product list
+func (s *Server) products(ctx context.Context) ([]string, error) {
+	e := s.entryFor("")
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !s.fresh(e) {
+		products, err := s.client.FetchProducts(ctx)
+		if err == nil {
+			e.products, e.ok = products, true
+		}
+		e.fetched, e.err = s.now(), err
+	}
+	return e.products, e.result()
+}
+
+// cycles returns the memoized release cycles of a product
+func (s *Server) cycles(ctx context.Context, name string) (string, []api.Cycle, error) {
+	products, err := s.products(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	product, found := search.Resolve(products, s.aliases, name)
+	if !found {
+		return "", nil, fmt.Errorf("%w '%s'", errUnknownProduct, name)
+	}
+
+	e := s.entryFor(product)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !s.fresh(e) {
+		cycles, err := s.client.FetchProduct(ctx, product)
+		if err == nil {
+			e.cycles, e.ok = cycles, true
+		}
+		e.fetched, e.err = s.now(), err
+	}
+	return product, e.cycles, e.result()
+}
+
+// filter holds the query parameters that select cycles
+type filter struct {
+	statuses []api.Status
+	all      bool
+	lts      bool
+}
+
+// parseFilter reads the all, lts and status query parameters
+func parseFilter(r *http.Request) (filter, error) {
+	var f filter
+	q := r.URL.Query()
+
+	for name, dst := range map[string]*bool{"all": &f.all, "lts": &f.lts} {
+		if v := q.Get(name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return f, fmt.Errorf("invalid value for %s: %s", name, v)
+			}
+			*dst = b
+		}
+	}
+
+	if v := q.Get("status"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			status, err := api.ParseStatus(name)
+			if err != nil {
+				return f, err
+			}
+			f.statuses = append(f.statuses, status)
+		}
+		// Asking for EOL cycles implies showing them
+		if slices.Contains(f.statuses, api.StatusEOL) {
+			f.all = true
+		}
+	}
+
+	return f, nil
+}
+
+// apply returns the cycles matching the filter at now
+func (f filter) apply(cycles []api.Cycle, now time.Time, warnDays int) []api.Cycle {
+	out := make([]api.Cycle, 0, len(cycles))
+	for _, c := range cycles {
+		if !f.all && c.EOL.IsEOLAt(now) {
+			continue
+		}
+		if f.lts && !c.LTS.IsLTS() {
+			continue
+		}
+		if len(f.statuses) > 0 && !slices.Contains(f.statuses, c.StatusAt(now, warnDays)) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	products, err := s.products(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = ui.WriteHTMLIndex(w, products)
+}
+
+func (s *Server) handleProductPage(w http.ResponseWriter, r *http.Request) {
+	f, err := parseFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	product, cycles, err := s.cycles(r.Context(), r.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_ = ui.WriteHTMLPage(w, product, f.apply(cycles, s.now(), s.warnDays), ui.Options{ShowAll: f.all, WarnDays: s.warnDays, Clock: s.now}, r.URL.Query())
+}
+
+func (s *Server) handleProducts(w http.ResponseWriter, r *http.Request) {
+	products, err := s.products(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(products)
+}
+
+func (s *Server) handleProduct(w http.ResponseWriter, r *http.Request) {
+	f, err := parseFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	product, cycles, err := s.cycles(r.Context(), r.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	now := s.now()
+	w.Header().Set("Content-Type", "application/json")
+	_ = ui.WriteJSON(w, product, f.apply(cycles, now, s.warnDays), ui.Options{ShowAll: true, WarnDays: s.warnDays}, now)
+}
+
+// writeError maps err to a 404 for unknown products and a 502 otherwise
+func writeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errUnknownProduct) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/search"
+)
+
+// newUpstream fakes the endoflife.date API and counts requests per path
+func newUpstream(t *testing.T, hits map[string]*atomic.Int32) *httptest.Server {
+	t.Helper()
+	responses := map[string]string{
+		"/all.json": `["nodejs","python"]`,
+		"/python.json": `[` +
+			`{"cycle":"3.13","latest":"3.13.11","eol":"2029-10-31","support":"2026-10-01","lts":false},` +
+			`{"cycle":"3.12","latest":"3.12.12","eol":"2028-10-31","support":"2025-04-02","lts":true},` +
+			`{"cycle":"2.7","latest":"2.7.18","eol":"2020-01-01","support":"2015-01-01","lts":false}]`,
+	}
+	for path := range responses {
+		hits[path] = &atomic.Int32{}
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		hits[r.URL.Path].Add(1)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// newTestServer returns a dashboard server backed by a fake upstream at a fixed time
+func newTestServer(t *testing.T) (*httptest.Server, *Server, map[string]*atomic.Int32) {
+	t.Helper()
+	hits := make(map[string]*atomic.Int32)
+	upstream := newUpstream(t, hits)
+
+	aliases := search.BuiltinAliases.With(map[string]string{"snake": "python"})
+	s := New(api.NewClient(api.WithBaseURL(upstream.URL)), aliases, time.Hour, api.DefaultWarnDays)
+	s.now = func() time.Time { return time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC) }
+
+	srv := httptest.NewServer(s.Handler())
+	t.Cleanup(srv.Close)
+	return srv, s, hits
+}
+
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(url) //nolint:noctx,gosec // test request
+	if err != nil {
+		t.Fatalf("GET %s error = %v", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestServer_ProductAPI(t *testing.T) {
+	srv, _, _ := newTestServer(t)
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{"3.13", "3.12"}},
+		{query: "?all=true", want: []string{"3.13", "3.12", "2.7"}},
+		{query: "?lts=true", want: []string{"3.12"}},
+		{query: "?status=security-only", want: []string{"3.12"}},
+		{query: "?status=eol", want: []string{"2.7"}},
+		{query: "?status=active,eol", want: []string{"3.13", "2.7"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			status, body := get(t, srv.URL+"/api/products/python"+tt.query)
+			if status != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", status, body)
+			}
+
+			var doc struct {
+				Product string `json:"product"`
+				Cycles  []struct {
+					Cycle string `json:"cycle"`
+				} `json:"cycles"`
+			}
+			if err := json.Unmarshal([]byte(body), &doc); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+
+			got := make([]string, len(doc.Cycles))
+			for i, c := range doc.Cycles {
+				got[i] = c.Cycle
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("cycles = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	srv, _, _ := newTestServer(t)
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{path: "/api/products/cobol", want: http.StatusNotFound},
+		{path: "/products/cobol", want: http.StatusNotFound},
+		{path: "/api/products/python?status=unknown", want: http.StatusBadRequest},
+		{path: "/api/products/python?lts=maybe", want: http.StatusBadRequest},
+		{path: "/nowhere", want: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if status, _ := get(t, srv.URL+tt.path); status != tt.want {
+				t.Errorf("GET %s status = %d, want %d", tt.path, status, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_HTML(t *testing.T) {
+	srv, _, _ := newTestServer(t)
+
+	_, index := get(t, srv.URL+"/")
+	if !strings.Contains(index, `<a href="/products/python">python</a>`) {
+		t.Errorf("index page does not link to python:\n%s", index)
+	}
+
+	_, page := get(t, srv.URL+"/products/python")
+	for _, want := range []string{"<!DOCTYPE html>", "<style>", "<h1>Release cycles for python</h1>", "<td>3.13</td>"} {
+		if !strings.Contains(page, want) {
+			t.Errorf("product page missing %q", want)
+		}
+	}
+
+	// The link to the end-of-life cycles keeps the other filters
+	_, page = get(t, srv.URL+"/products/python?lts=true")
+	if !strings.Contains(page, `<a href="?all=true&amp;lts=true">`) {
+		t.Errorf("product page does not keep the filters in the link to all cycles:\n%s", page)
+	}
+}
+
+func TestServer_CachesUpstream(t *testing.T) {
+	srv, s, hits := newTestServer(t)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			get(t, srv.URL+"/api/products/python")
+		}()
+	}
+	wg.Wait()
+	get(t, srv.URL+"/products/python")
+
+	if n := hits["/python.json"].Load(); n != 1 {
+		t.Errorf("upstream hits for python = %d, want 1", n)
+	}
+	if n := hits["/all.json"].Load(); n != 1 {
+		t.Errorf("upstream hits for the product list = %d, want 1", n)
+	}
+
+	// After the TTL the data is fetched again
+	s.now = func() time.Time { return time.Date(2026, 1, 15, 13, 0, 1, 0, time.UTC) }
+	get(t, srv.URL+"/api/products/python")
+	if n := hits["/python.json"].Load(); n != 2 {
+		t.Errorf("upstream hits for python after TTL = %d, want 2", n)
+	}
+}
+
+func TestServer_CachesErrors(t *testing.T) {
+	var (
+		hits atomic.Int32
+		fail atomic.Bool
+	)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/all.json" {
+			_, _ = w.Write([]byte(`["python"]`))
+			return
+		}
+		hits.Add(1)
+		if fail.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"cycle":"3.13","latest":"3.13.11","eol":"2029-10-31"}]`))
+	}))
+	t.Cleanup(upstream.Close)
+
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	s := New(api.NewClient(api.WithBaseURL(upstream.URL)), search.BuiltinAliases, time.Hour, api.DefaultWarnDays)
+	s.now = func() time.Time { return now }
+	srv := httptest.NewServer(s.Handler())
+	t.Cleanup(srv.Close)
+
+	// A failure is remembered for errorTTL
+	fail.Store(true)
+	for range 3 {
+		if status, _ := get(t, srv.URL+"/api/products/python"); status == http.StatusOK {
+			t.Fatalf("status = %d while upstream fails", status)
+		}
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("upstream hits within errorTTL = %d, want 1", n)
+	}
+
+	// Afterwards the fetch is retried
+	fail.Store(false)
+	now = now.Add(errorTTL)
+	if status, body := get(t, srv.URL+"/api/products/python"); status != http.StatusOK {
+		t.Fatalf("status after errorTTL = %d, want 200: %s", status, body)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("upstream hits after errorTTL = %d, want 2", n)
+	}
+
+	// Once the TTL expired, a failing fetch serves the stale data
+	fail.Store(true)
+	now = now.Add(time.Hour)
+	for range 3 {
+		if status, body := get(t, srv.URL+"/api/products/python"); status != http.StatusOK || !strings.Contains(body, `"3.13"`) {
+			t.Fatalf("status = %d, want stale data: %s", status, body)
+		}
+	}
+	if n := hits.Load(); n != 3 {
+		t.Errorf("upstream hits with stale data = %d, want 3", n)
+	}
+}
+
+func TestServer_Alias(t *testing.T) {
+	srv, _, _ := newTestServer(t)
+
+	for _, alias := range []string{"py", "snake"} {
+		status, body := get(t, srv.URL+"/api/products/"+alias)
+		if status != http.StatusOK {
+			t.Fatalf("status = %d, want 200: %s", status, body)
+		}
+		if !strings.Contains(body, `"product": "python"`) {
+			t.Errorf("alias %s did not resolve to python: %s", alias, body)
+		}
+	}
+}
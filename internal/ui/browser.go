@@ -0,0 +1,704 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/search"
+	"github.com/oliverandrich/eol-date/internal/version"
+)
+
+// browserChrome is the number of lines around the body besides the help
+// bar: the title and the blank lines below it and above the help bar
+const browserChrome = 3
+
+// browserView is the screen the browser shows
+type browserView int
+
+const (
+	productsView browserView = iota // product list
+	cyclesView                      // cycles table of one product
+	detailView                      // all fields of one cycle
+)
+
+// sortOrder is the order of the cycles table
+type sortOrder int
+
+const (
+	sortAPI      sortOrder = iota // as returned by the API, newest first
+	sortCycle                     // by cycle version
+	sortReleased                  // by release date
+	sortEOL                       // by end of life
+)
+
+// String returns the name shown in the status line
+func (s sortOrder) String() string {
+	switch s {
+	case sortCycle:
+		return "cycle"
+	case sortReleased:
+		return "release date"
+	case sortEOL:
+		return "end of life"
+	case sortAPI:
+	}
+	return "api order"
+}
+
+// browserKeys are the key bindings of the browser. Bindings that do not
+// apply to the current view are disabled, which hides them from the help bar.
+type browserKeys struct {
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Top      key.Binding
+	Bottom   key.Binding
+	Open     key.Binding
+	Back     key.Binding
+	Filter   key.Binding
+	Sort     key.Binding
+	Reverse  key.Binding
+	ShowAll  key.Binding
+	Help     key.Binding
+	Quit     key.Binding
+}
+
+func newBrowserKeys() browserKeys {
+	return browserKeys{
+		Up:       key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Down:     key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		PageUp:   key.NewBinding(key.WithKeys("pgup", "ctrl+b"), key.WithHelp("pgup", "page up")),
+		PageDown: key.NewBinding(key.WithKeys("pgdown", "ctrl+f"), key.WithHelp("pgdn", "page down")),
+		Top:      key.NewBinding(key.WithKeys("home", "g"), key.WithHelp("g/home", "first")),
+		Bottom:   key.NewBinding(key.WithKeys("end", "G"), key.WithHelp("G/end", "last")),
+		Open:     key.NewBinding(key.WithKeys("enter", "l", "right"), key.WithHelp("enter", "open")),
+		Back:     key.NewBinding(key.WithKeys("esc", "backspace", "h", "left"), key.WithHelp("esc", "back")),
+		Filter:   key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "filter")),
+		Sort:     key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "sort")),
+		Reverse:  key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reverse")),
+		ShowAll:  key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "toggle EOL")),
+		Help:     key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "more keys")),
+		Quit:     key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+	}
+}
+
+// ShortHelp returns the bindings shown in the help bar
+func (k browserKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Open, k.Back, k.Filter, k.Sort, k.ShowAll, k.Help, k.Quit}
+}
+
+// FullHelp returns the bindings shown in the expanded help
+func (k browserKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.PageUp, k.PageDown, k.Top, k.Bottom},
+		{k.Open, k.Back, k.Filter},
+		{k.Sort, k.Reverse, k.ShowAll},
+		{k.Help, k.Quit},
+	}
+}
+
+// cyclesMsg delivers the release cycles of a product
+type cyclesMsg struct {
+	err     error
+	product string
+	cycles  []api.Cycle
+}
+
+// browser is the Bubble Tea model of the full-screen browser
+type browser struct {
+	fetch  FetchFunc
+	cache  map[string][]api.Cycle
+	err    error
+	opts   Options
+	help   help.Model
+	keys   browserKeys
+	filter textinput.Model
+	// products lists all products, matches the ones passing the filter
+	products []string
+	matches  []string
+	// product is the product whose cycles are shown
+	product string
+	// visible are the cycles of product in table order, rows their display rows
+	visible       []api.Cycle
+	rows          []displayRow
+	view          browserView
+	order         sortOrder
+	productCursor int
+	productOffset int
+	cycleCursor   int
+	cycleOffset   int
+	width         int
+	height        int
+	loading       bool
+	filtering     bool
+	showAll       bool
+	reverse       bool
+}
+
+// newBrowser creates the browser over products. If product is not empty,
+// the browser starts with its release cycles.
+func newBrowser(products []string, product string, fetch FetchFunc, opts Options) browser {
+	filter := textinput.New()
+	filter.Prompt = "/ "
+	filter.Placeholder = "filter products"
+
+	b := browser{
+		fetch:    fetch,
+		cache:    make(map[string][]api.Cycle),
+		opts:     opts,
+		help:     help.New(),
+		keys:     newBrowserKeys(),
+		filter:   filter,
+		products: products,
+		matches:  products,
+		showAll:  opts.ShowAll,
+		width:    80,
+		height:   24,
+	}
+	b.help.Styles.ShortKey = pickerHelpStyle.UnsetPaddingLeft().Bold(true)
+	b.help.Styles.ShortDesc = pickerHelpStyle.UnsetPaddingLeft()
+	b.help.Styles.ShortSeparator = pickerHelpStyle.UnsetPaddingLeft()
+	b.help.Styles.FullKey = b.help.Styles.ShortKey
+	b.help.Styles.FullDesc = b.help.Styles.ShortDesc
+	b.help.Styles.FullSeparator = b.help.Styles.ShortSeparator
+
+	if i := slices.Index(products, product); i >= 0 {
+		b.productCursor = i
+		b.product = product
+		b.view = cyclesView
+		b.loading = true
+	}
+	b.updateKeys()
+	return b
+}
+
+// updateKeys enables the key bindings that apply to the current view
+func (b *browser) updateKeys() {
+	b.keys.Open.SetEnabled(b.view != detailView)
+	b.keys.Back.SetEnabled(b.view != productsView || b.filter.Value() != "")
+	b.keys.Filter.SetEnabled(b.view == productsView)
+	b.keys.Sort.SetEnabled(b.view == cyclesView)
+	b.keys.Reverse.SetEnabled(b.view == cyclesView)
+	b.keys.ShowAll.SetEnabled(b.view == cyclesView)
+
+	if b.view == productsView {
+		b.keys.Back.SetHelp("esc", "clear filter")
+	} else {
+		b.keys.Back.SetHelp("esc", "back")
+	}
+	if b.help.ShowAll {
+		b.keys.Help.SetHelp("?", "fewer keys")
+	} else {
+		b.keys.Help.SetHelp("?", "more keys")
+	}
+}
+
+// bodyHeight is the number of lines available for the current view
+func (b *browser) bodyHeight() int {
+	return max(3, b.height-browserChrome-lipgloss.Height(b.helpView()))
+}
+
+// listRows is the number of list or table rows below the status line and,
+// in the cycles view, the table header
+func (b *browser) listRows() int {
+	if b.view == cyclesView {
+		return max(1, b.bodyHeight()-2)
+	}
+	return max(1, b.bodyHeight()-1)
+}
+
+// applyFilter ranks the products by the filter, or lists all of them if it
+// is empty
+func (b *browser) applyFilter() {
+	if query := b.filter.Value(); query != "" {
+		b.matches = search.Names(search.FindSimilar(b.products, query, len(b.products)))
+	} else {
+		b.matches = b.products
+	}
+	b.productCursor = 0
+	b.productOffset = 0
+}
+
+// scroll moves cursor by delta within n entries and keeps it visible in a
+// window of rows entries starting at offset
+func scroll(cursor, offset, delta, n, rows int) (int, int) {
+	if n == 0 {
+		return 0, 0
+	}
+	cursor = max(0, min(n-1, cursor+delta))
+	if cursor < offset {
+		offset = cursor
+	}
+	if cursor >= offset+rows {
+		offset = cursor - rows + 1
+	}
+	return cursor, offset
+}
+
+// move moves the cursor of the current view by delta
+func (b *browser) move(delta int) {
+	switch b.view {
+	case productsView:
+		b.productCursor, b.productOffset = scroll(b.productCursor, b.productOffset, delta, len(b.matches), b.listRows())
+	case cyclesView, detailView:
+		b.cycleCursor, b.cycleOffset = scroll(b.cycleCursor, b.cycleOffset, delta, len(b.rows), b.listRows())
+	}
+}
+
+// open shows the cycles of product, fetching them unless they are cached
+func (b *browser) open(product string) tea.Cmd {
+	b.product = product
+	b.view = cyclesView
+	b.cycleCursor = 0
+	b.cycleOffset = 0
+	b.err = nil
+
+	if cycles, ok := b.cache[product]; ok {
+		b.loading = false
+		b.arrange(cycles)
+		return nil
+	}
+	b.loading = true
+	b.visible = nil
+	b.rows = nil
+	return b.fetchCycles(product)
+}
+
+// fetchCycles returns a command fetching the cycles of product
+func (b *browser) fetchCycles(product string) tea.Cmd {
+	if b.fetch == nil {
+		return nil
+	}
+	fetch := b.fetch
+	return func() tea.Msg {
+		cycles, err := fetch(product)
+		return cyclesMsg{product: product, cycles: cycles, err: err}
+	}
+}
+
+// arrange filters and sorts cycles into the rows of the cycles table
+func (b *browser) arrange(cycles []api.Cycle) {
+	now := b.opts.now()
+	visible := make([]api.Cycle, 0, len(cycles))
+	for _, c := range cycles {
+		if b.showAll || !c.EOL.IsEOLAt(now) {
+			visible = append(visible, c)
+		}
+	}
+	b.visible = sortCycles(visible, b.order, b.reverse)
+	b.rows = prepareDisplayRows(b.visible, true, b.opts.WarnDays, now)
+	b.cycleCursor, b.cycleOffset = scroll(b.cycleCursor, b.cycleOffset, 0, len(b.rows), b.listRows())
+}
+
+// sortCycles returns a copy of cycles in the given order
+func sortCycles(cycles []api.Cycle, order sortOrder, reverse bool) []api.Cycle {
+	sorted := slices.Clone(cycles)
+	switch order {
+	case sortCycle:
+		slices.SortStableFunc(sorted, func(a, b api.Cycle) int { return compareCycles(a.Cycle, b.Cycle) })
+	case sortReleased:
+		slices.SortStableFunc(sorted, func(a, b api.Cycle) int { return a.ReleaseDate.Compare(b.ReleaseDate.Time) })
+	case sortEOL:
+		slices.SortStableFunc(sorted, func(a, b api.Cycle) int { return endOfLife(a.EOL).Compare(endOfLife(b.EOL)) })
+	case sortAPI:
+	}
+	if reverse {
+		slices.Reverse(sorted)
+	}
+	return sorted
+}
+
+// compareCycles compares cycle names as versions, or as strings if either
+// is not numeric, e.g. a Debian codename
+func compareCycles(a, b string) int {
+	va, errA := version.Parse(a)
+	vb, errB := version.Parse(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return version.Compare(va, vb)
+}
+
+// endOfLife returns the date an end of life value sorts by: cycles that
+// ended without a date come first, cycles without an end last
+func endOfLife(v api.EOLValue) time.Time {
+	switch {
+	case !v.IsBoolean && !v.DateValue.IsZero():
+		return v.DateValue
+	case v.IsBoolean && v.BoolValue:
+		return time.Time{}
+	}
+	return time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+}
+
+func (b browser) Init() tea.Cmd {
+	if b.loading {
+		return b.fetchCycles(b.product)
+	}
+	return nil
+}
+
+func (b browser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		b.width = msg.Width
+		b.height = msg.Height
+		b.help.Width = msg.Width
+		b.move(0)
+		return b, nil
+
+	case cyclesMsg:
+		if msg.err == nil {
+			b.cache[msg.product] = msg.cycles
+		}
+		// A response for a product the user already left is only cached
+		if msg.product != b.product || !b.loading {
+			return b, nil
+		}
+		b.loading = false
+		b.err = msg.err
+		if msg.err == nil {
+			b.arrange(msg.cycles)
+		}
+		return b, nil
+
+	case tea.KeyMsg:
+		if b.filtering {
+			return b.updateFilter(msg)
+		}
+		return b.updateKey(msg)
+	}
+	return b, nil
+}
+
+// updateFilter handles keys while the product filter is edited
+func (b browser) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return b, tea.Quit
+	case tea.KeyEsc:
+		b.filtering = false
+		b.filter.Blur()
+		b.filter.SetValue("")
+		b.applyFilter()
+		b.updateKeys()
+		return b, nil
+	case tea.KeyEnter:
+		b.filtering = false
+		b.filter.Blur()
+		b.updateKeys()
+		return b, nil
+	case tea.KeyUp:
+		b.move(-1)
+		return b, nil
+	case tea.KeyDown:
+		b.move(1)
+		return b, nil
+	}
+
+	before := b.filter.Value()
+	var cmd tea.Cmd
+	b.filter, cmd = b.filter.Update(msg)
+	if b.filter.Value() != before {
+		b.applyFilter()
+	}
+	return b, cmd
+}
+
+// updateKey handles keys outside the product filter
+func (b browser) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch {
+	case key.Matches(msg, b.keys.Quit):
+		return b, tea.Quit
+	case key.Matches(msg, b.keys.Help):
+		b.help.ShowAll = !b.help.ShowAll
+		b.move(0)
+	case key.Matches(msg, b.keys.Up):
+		b.move(-1)
+	case key.Matches(msg, b.keys.Down):
+		b.move(1)
+	case key.Matches(msg, b.keys.PageUp):
+		b.move(-b.listRows())
+	case key.Matches(msg, b.keys.PageDown):
+		b.move(b.listRows())
+	case key.Matches(msg, b.keys.Top):
+		b.move(-math.MaxInt32)
+	case key.Matches(msg, b.keys.Bottom):
+		b.move(math.MaxInt32)
+	case key.Matches(msg, b.keys.Filter):
+		b.filtering = true
+		cmd = b.filter.Focus()
+	case key.Matches(msg, b.keys.Open):
+		cmd = b.openSelected()
+	case key.Matches(msg, b.keys.Back):
+		b.back()
+	case key.Matches(msg, b.keys.Sort):
+		b.order = (b.order + 1) % (sortEOL + 1)
+		b.rearrange()
+	case key.Matches(msg, b.keys.Reverse):
+		b.reverse = !b.reverse
+		b.rearrange()
+	case key.Matches(msg, b.keys.ShowAll):
+		b.showAll = !b.showAll
+		b.rearrange()
+	}
+	b.updateKeys()
+	return b, cmd
+}
+
+// openSelected opens the product or cycle under the cursor
+func (b *browser) openSelected() tea.Cmd {
+	switch b.view {
+	case productsView:
+		if b.productCursor < len(b.matches) {
+			return b.open(b.matches[b.productCursor])
+		}
+	case cyclesView:
+		if b.cycleCursor < len(b.rows) {
+			b.view = detailView
+		}
+	case detailView:
+	}
+	return nil
+}
+
+// back returns to the previous view, or clears the filter of the product list
+func (b *browser) back() {
+	switch b.view {
+	case detailView:
+		b.view = cyclesView
+		b.move(0)
+	case cyclesView:
+		b.view = productsView
+		b.loading = false
+		b.move(0)
+	case productsView:
+		b.filter.SetValue("")
+		b.applyFilter()
+	}
+}
+
+// rearrange rebuilds the cycles table after the order or filter changed,
+// keeping the cursor on the same cycle
+func (b *browser) rearrange() {
+	cycles, ok := b.cache[b.product]
+	if !ok || b.view != cyclesView {
+		return
+	}
+	var selected string
+	if b.cycleCursor < len(b.visible) {
+		selected = b.visible[b.cycleCursor].Cycle
+	}
+	b.arrange(cycles)
+	if i := slices.IndexFunc(b.visible, func(c api.Cycle) bool { return c.Cycle == selected }); i >= 0 {
+		b.cycleCursor, b.cycleOffset = scroll(i, b.cycleOffset, 0, len(b.rows), b.listRows())
+	}
+}
+
+func (b browser) View() string {
+	var body string
+	switch b.view {
+	case productsView:
+		body = b.productsView()
+	case cyclesView:
+		body = b.cyclesView()
+	case detailView:
+		body = b.detailView()
+	}
+
+	body = lipgloss.NewStyle().Height(b.bodyHeight()).MaxHeight(b.bodyHeight()).Render(body)
+	return b.titleView() + "\n\n" + body + "\n\n" + b.helpView()
+}
+
+// titleView renders the breadcrumb of the current view
+func (b browser) titleView() string {
+	crumbs := []string{"eol-date"}
+	if b.view != productsView {
+		crumbs = append(crumbs, b.product)
+	}
+	if b.view == detailView && b.cycleCursor < len(b.rows) {
+		crumbs = append(crumbs, b.rows[b.cycleCursor].Cycle)
+	}
+	return headerStyle.Render(truncate(strings.Join(crumbs, " › "), max(b.width, 1)))
+}
+
+// helpView renders the help bar
+func (b browser) helpView() string {
+	return pickerHelpStyle.Render(b.help.View(b.keys))
+}
+
+// productsView renders the filter and the product list
+func (b browser) productsView() string {
+	var status string
+	switch {
+	case b.filtering:
+		status = b.filter.View()
+	case b.filter.Value() != "":
+		status = dimStyle.Render(fmt.Sprintf("/ %s · %d of %d products", b.filter.Value(), len(b.matches), len(b.products)))
+	default:
+		status = dimStyle.Render(fmt.Sprintf("%d products", len(b.products)))
+	}
+
+	lines := []string{status}
+	end := min(len(b.matches), b.productOffset+b.listRows())
+	for i := b.productOffset; i < end; i++ {
+		line := truncate(b.matches[i], max(b.width-4, 1))
+		if i == b.productCursor {
+			lines = append(lines, pickerCursorStyle.Render("> "+line))
+		} else {
+			lines = append(lines, pickerItemStyle.Render(line))
+		}
+	}
+	if len(b.matches) == 0 {
+		lines = append(lines, pickerItemStyle.Render(dimStyle.Render("no matching products")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// browserColumns returns the columns of the cycles table: the selected
+// columns without the status, which is shown through colors, and the link,
+// which the detail view shows
+func browserColumns(rows []displayRow, selected []string) []column {
+	return slices.DeleteFunc(visibleColumns(rows, selected), func(c column) bool {
+		return c.noTable || c.header == "LINK"
+	})
+}
+
+// browserCell returns the text of a table cell
+func browserCell(c column, r displayRow) string {
+	switch c.kind {
+	case dateColumn:
+		rel, raw := c.date(r)
+		if date := dateOnly(raw); rel != "" && date != "" {
+			return rel + " " + date
+		}
+		return rel
+	case ltsColumn:
+		return c.display(r, formatMarkdownDate)
+	case textColumn:
+	}
+	return c.text(r)
+}
+
+// cyclesView renders the status line and the cycles table
+func (b browser) cyclesView() string {
+	switch {
+	case b.loading:
+		return dimStyle.Render("Loading…")
+	case b.err != nil:
+		return lipgloss.NewStyle().Foreground(theme.EOL).Render(b.err.Error())
+	}
+
+	total := len(b.cache[b.product])
+	status := fmt.Sprintf("%d of %d cycles · sorted by %s", len(b.rows), total, b.order)
+	if b.reverse {
+		status += " (reversed)"
+	}
+	if !b.showAll && len(b.rows) < total {
+		status += " · EOL cycles hidden"
+	}
+	lines := []string{dimStyle.Render(status)}
+
+	if len(b.rows) == 0 {
+		lines = append(lines, "", "No active release cycles")
+		return strings.Join(lines, "\n")
+	}
+
+	cols := browserColumns(b.rows, b.opts.Columns)
+	widths := make([]int, len(cols))
+	for i, c := range cols {
+		widths[i] = lipgloss.Width(c.header)
+		for _, r := range b.rows {
+			widths[i] = max(widths[i], lipgloss.Width(browserCell(c, r)))
+		}
+	}
+	line := func(cells []string) string {
+		padded := make([]string, len(cells))
+		for i, cell := range cells {
+			padded[i] = cell + strings.Repeat(" ", widths[i]-lipgloss.Width(cell))
+		}
+		return truncate(strings.Join(padded, "  "), max(b.width-2, 1))
+	}
+
+	lines = append(lines, tableHeaderStyle.Render("  "+line(headers(cols))))
+	end := min(len(b.rows), b.cycleOffset+b.listRows())
+	for i := b.cycleOffset; i < end; i++ {
+		r := b.rows[i]
+		cells := make([]string, len(cols))
+		for j, c := range cols {
+			cells[j] = browserCell(c, r)
+		}
+		style := lipgloss.NewStyle().Foreground(statusColor(r.Status))
+		if i == b.cycleCursor {
+			lines = append(lines, style.Bold(true).Render("> "+line(cells)))
+		} else {
+			lines = append(lines, style.Render("  "+line(cells)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// detailView renders every field of the cycle under the cursor
+func (b browser) detailView() string {
+	if b.cycleCursor >= len(b.rows) {
+		return ""
+	}
+	r := b.rows[b.cycleCursor]
+	c := b.visible[b.cycleCursor]
+
+	type field struct {
+		label string
+		value string
+	}
+	var fields []field
+	for _, col := range allColumns {
+		value := col.display(r, formatMarkdownDate)
+		if col.header == "PRODUCT" || (col.optional && value == "") {
+			continue
+		}
+		if col.kind == ltsColumn {
+			value = c.LTS.String()
+		}
+		fields = append(fields, field{col.header, value})
+		if col.header == "LATEST" && !c.LatestReleaseDate.IsZero() {
+			latest := formatRelease(c.LatestReleaseDate.Time, b.opts.now())
+			fields = append(fields, field{"LATEST DATE", formatMarkdownDate(latest.relative, latest.date)})
+		}
+	}
+	fields = append(fields, field{"PAGE", "https://endoflife.date/" + b.product})
+
+	lines := make([]string, 0, len(fields))
+	for _, f := range fields {
+		label := tableHeaderStyle.Render(fmt.Sprintf("%-14s", f.label))
+		value := f.value
+		if f.label == "STATUS" {
+			value = lipgloss.NewStyle().Foreground(statusColor(r.Status)).Render(value)
+		}
+		lines = append(lines, label+value)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Browse runs the full-screen browser over products until the user quits.
+// The release cycles of the chosen product are fetched through fetch; if
+// product is not empty, the browser starts with its cycles.
+func Browse(products []string, product string, fetch FetchFunc, opts Options) error {
+	if _, err := tea.NewProgram(newBrowser(products, product, fetch, opts), tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("error running browser: %w", err)
+	}
+	return nil
+}
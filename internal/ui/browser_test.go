@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+var browserNow = time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+func browserDate(s string) api.Date {
+	t, _ := time.Parse("2006-01-02", s)
+	return api.Date{Time: t}
+}
+
+func browserEOL(s string) api.EOLValue {
+	return api.EOLValue{DateValue: browserDate(s).Time}
+}
+
+// browserCycles are python's cycles in API order as of browserNow: two of
+// them reached their end of life
+var browserCycles = []api.Cycle{
+	{Cycle: "3.13", Latest: "3.13.1", ReleaseDate: browserDate("2024-10-07"), Support: browserEOL("2026-10-01"), EOL: browserEOL("2029-10-31"), Link: "https://docs.python.org/3.13/whatsnew/"},
+	{Cycle: "3.12", Latest: "3.12.8", ReleaseDate: browserDate("2023-10-02"), Support: browserEOL("2025-04-02"), EOL: browserEOL("2028-10-31")},
+	{Cycle: "3.10", Latest: "3.10.16", ReleaseDate: browserDate("2021-10-04"), EOL: browserEOL("2030-01-01")},
+	{Cycle: "3.9", Latest: "3.9.21", ReleaseDate: browserDate("2020-10-05"), EOL: browserEOL("2025-10-31")},
+	{Cycle: "2.7", Latest: "2.7.18", ReleaseDate: browserDate("2010-07-03"), EOL: api.EOLValue{IsBoolean: true, BoolValue: true}},
+}
+
+var browserProducts = []string{"go", "nodejs", "python"}
+
+// browserFetch serves browserCycles for python and fails for other products,
+// recording every request
+type browserFetch struct {
+	mu        sync.Mutex
+	requested []string
+}
+
+func (f *browserFetch) fetch(product string) ([]api.Cycle, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requested = append(f.requested, product)
+	if product != "python" {
+		return nil, errors.New("product " + product + " not found")
+	}
+	return browserCycles, nil
+}
+
+func newTestBrowser(product string) (browser, *browserFetch) {
+	f := &browserFetch{}
+	b := newBrowser(browserProducts, product, f.fetch, Options{WarnDays: 90, Clock: api.FixedClock(browserNow)})
+	// A blinking cursor would make browse wait for its ticks
+	b.filter.Cursor.SetMode(cursor.CursorStatic)
+	return b, f
+}
+
+// browse sends msgs to the browser and runs the commands they return, as
+// long as those deliver release cycles
+func browse(b browser, msgs ...tea.Msg) browser {
+	for _, msg := range msgs {
+		m, cmd := b.Update(msg)
+		b = m.(browser)
+		if cmd == nil {
+			continue
+		}
+		if result, ok := cmd().(cyclesMsg); ok {
+			b = browse(b, result)
+		}
+	}
+	return b
+}
+
+// visibleCycles returns the names of the cycles in the table
+func visibleCycles(b browser) []string {
+	names := make([]string, len(b.visible))
+	for i, c := range b.visible {
+		names[i] = c.Cycle
+	}
+	return names
+}
+
+var (
+	keyEnter = tea.KeyMsg{Type: tea.KeyEnter}
+	keyEsc   = tea.KeyMsg{Type: tea.KeyEsc}
+)
+
+func TestSortCycles(t *testing.T) {
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name    string
+		order   sortOrder
+		reverse bool
+		want    []string
+	}{
+		{"api order", sortAPI, false, []string{"3.13", "3.12", "3.10", "3.9", "2.7"}},
+		{"api order reversed", sortAPI, true, []string{"2.7", "3.9", "3.10", "3.12", "3.13"}},
+		{"cycle as version", sortCycle, false, []string{"2.7", "3.9", "3.10", "3.12", "3.13"}},
+		{"cycle reversed", sortCycle, true, []string{"3.13", "3.12", "3.10", "3.9", "2.7"}},
+		{"release date", sortReleased, false, []string{"2.7", "3.9", "3.10", "3.12", "3.13"}},
+		{"end of life, ended without date first", sortEOL, false, []string{"2.7", "3.9", "3.12", "3.13", "3.10"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := sortCycles(browserCycles, tt.order, tt.reverse)
+			got := make([]string, len(sorted))
+			for i, c := range sorted {
+				got[i] = c.Cycle
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("sortCycles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if browserCycles[0].Cycle != "3.13" {
+		t.Error("sortCycles() modified its input")
+	}
+}
+
+func TestCompareCycles(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"3.9", "3.10", -1},
+		{"10", "9", 1},
+		{"22.04", "22.04", 0},
+		{"bookworm", "bullseye", -1},
+		{"12", "bookworm", -1},
+	}
+
+	for _, tt := range tests {
+		if got := compareCycles(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareCycles(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestBrowser_OpenProduct(t *testing.T) {
+	b, f := newTestBrowser("")
+	b = browse(b, typed("G"), keyEnter)
+
+	if b.view != cyclesView || b.product != "python" {
+		t.Fatalf("view = %v, product = %q; want the cycles of python", b.view, b.product)
+	}
+	if got := visibleCycles(b); !slices.Equal(got, []string{"3.13", "3.12", "3.10"}) {
+		t.Errorf("cycles = %v, want the active ones", got)
+	}
+	if view := b.View(); !strings.Contains(view, "eol-date › python") || !strings.Contains(view, "3 of 5 cycles") {
+		t.Errorf("View() = %q", view)
+	}
+
+	b = browse(b, keyEsc, keyEnter)
+	if len(f.requested) != 1 {
+		t.Errorf("requested = %v, want the cycles to be fetched once", f.requested)
+	}
+}
+
+func TestBrowser_StartsWithProduct(t *testing.T) {
+	b, _ := newTestBrowser("python")
+	if b.view != cyclesView || !b.loading {
+		t.Fatalf("view = %v, loading = %v", b.view, b.loading)
+	}
+
+	b = browse(b, b.Init()())
+	if len(b.rows) != 3 {
+		t.Errorf("rows = %d, want 3", len(b.rows))
+	}
+
+	b = browse(b, keyEsc)
+	if b.view != productsView || b.matches[b.productCursor] != "python" {
+		t.Errorf("back to the product list did not keep the cursor on python")
+	}
+}
+
+func TestBrowser_ToggleAndSort(t *testing.T) {
+	b, _ := newTestBrowser("python")
+	b = browse(b, b.Init()(), typed("a"))
+
+	if got := visibleCycles(b); len(got) != 5 {
+		t.Fatalf("cycles = %v, want all 5 with EOL cycles", got)
+	}
+
+	b = browse(b, typed("s"))
+	if b.order != sortCycle {
+		t.Fatalf("order = %v, want cycle", b.order)
+	}
+	if got := visibleCycles(b); !slices.Equal(got, []string{"2.7", "3.9", "3.10", "3.12", "3.13"}) {
+		t.Errorf("cycles = %v, want sorted by version", got)
+	}
+	if b.visible[b.cycleCursor].Cycle != "3.13" {
+		t.Errorf("cursor on %s, want it to stay on 3.13", b.visible[b.cycleCursor].Cycle)
+	}
+
+	b = browse(b, typed("r"), typed("a"))
+	if got := visibleCycles(b); !slices.Equal(got, []string{"3.13", "3.12", "3.10"}) {
+		t.Errorf("cycles = %v, want the active ones reversed", got)
+	}
+	if !strings.Contains(b.View(), "sorted by cycle (reversed)") {
+		t.Errorf("View() does not show the order: %q", b.View())
+	}
+}
+
+func TestBrowser_Detail(t *testing.T) {
+	b, _ := newTestBrowser("python")
+	b = browse(b, b.Init()(), keyEnter)
+
+	if b.view != detailView {
+		t.Fatalf("view = %v, want detail", b.view)
+	}
+	view := b.View()
+	for _, want := range []string{
+		"eol-date › python › 3.13",
+		"3.13.1",
+		"2029-10-31",
+		"active",
+		"https://docs.python.org/3.13/whatsnew/",
+		"https://endoflife.date/python",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() lacks %q:\n%s", want, view)
+		}
+	}
+
+	b = browse(b, typed("j"))
+	if !strings.Contains(b.View(), "python › 3.12") || !strings.Contains(b.View(), "security-only") {
+		t.Errorf("down did not show the next cycle:\n%s", b.View())
+	}
+
+	b = browse(b, keyEsc)
+	if b.view != cyclesView || b.cycleCursor != 1 {
+		t.Errorf("view = %v, cursor = %d; want the table on 3.12", b.view, b.cycleCursor)
+	}
+}
+
+func TestBrowser_Filter(t *testing.T) {
+	b, _ := newTestBrowser("")
+	b = browse(b, typed("/"), typed("n"), typed("o"), typed("d"))
+
+	if !b.filtering || b.matches[0] != "nodejs" {
+		t.Fatalf("filtering = %v, matches = %v", b.filtering, b.matches)
+	}
+
+	// Keys like q are typed into the filter instead of quitting
+	b = browse(b, typed("q"))
+	if b.filter.Value() != "nodq" {
+		t.Errorf("filter = %q, want nodq", b.filter.Value())
+	}
+
+	b = browse(b, tea.KeyMsg{Type: tea.KeyBackspace}, keyEnter)
+	if b.filtering || b.filter.Value() != "nod" {
+		t.Errorf("enter should keep the filter: filtering = %v, filter = %q", b.filtering, b.filter.Value())
+	}
+
+	b = browse(b, keyEsc)
+	if b.filter.Value() != "" || len(b.matches) != len(browserProducts) {
+		t.Errorf("esc should clear the filter: filter = %q, matches = %v", b.filter.Value(), b.matches)
+	}
+}
+
+func TestBrowser_FetchError(t *testing.T) {
+	b, _ := newTestBrowser("")
+	b = browse(b, keyEnter)
+
+	if !strings.Contains(b.View(), "product go not found") {
+		t.Errorf("View() does not show the error:\n%s", b.View())
+	}
+}
+
+func TestBrowser_IgnoresStaleResponse(t *testing.T) {
+	b, _ := newTestBrowser("python")
+	stale := b.Init()()
+	b = browse(b, keyEsc, stale)
+
+	if b.view != productsView {
+		t.Errorf("view = %v, a late response must not leave the product list", b.view)
+	}
+	if _, ok := b.cache["python"]; !ok {
+		t.Error("the late response was not cached")
+	}
+}
+
+// waitForOutput waits until the program wrote want
+func waitForOutput(t *testing.T, tm *teatest.TestModel, want string) {
+	t.Helper()
+	teatest.WaitFor(t, tm.Output(), func(out []byte) bool {
+		return bytes.Contains(out, []byte(want))
+	}, teatest.WithDuration(3*time.Second), teatest.WithCheckInterval(10*time.Millisecond))
+}
+
+func TestBrowse_Program(t *testing.T) {
+	b, _ := newTestBrowser("")
+	tm := teatest.NewTestModel(t, b, teatest.WithInitialTermSize(100, 20))
+
+	waitForOutput(t, tm, "3 products")
+
+	tm.Type("G")
+	tm.Send(keyEnter)
+	waitForOutput(t, tm, "3 of 5 cycles")
+
+	tm.Send(keyEnter)
+	waitForOutput(t, tm, "https://endoflife.date/python")
+
+	tm.Type("q")
+	final, ok := tm.FinalModel(t, teatest.WithFinalTimeout(3*time.Second)).(browser)
+	if !ok || final.view != detailView || final.product != "python" {
+		t.Fatalf("final view = %v, product = %q", final.view, final.product)
+	}
+	assertGolden(t, "browser_detail.golden", []byte(final.View()))
+}
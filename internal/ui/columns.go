@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// columnKind determines how the cells of a column are rendered
+type columnKind int
+
+const (
+	textColumn columnKind = iota // plain text
+	dateColumn                   // relative and raw date
+	ltsColumn                    // LTS checkmark
+)
+
+// column describes an output column shared by all formats
+type column struct {
+	text   func(r displayRow) string
+	date   func(r displayRow) (rel, raw string)
+	header string
+	kind   columnKind
+	// optional columns are only shown when at least one row has a value
+	optional bool
+	// noTable columns are left out of the table, which uses colors instead
+	noTable bool
+}
+
+// allColumns lists every column in display order
+var allColumns = []column{
+	{header: "PRODUCT", kind: textColumn, optional: true, text: func(r displayRow) string { return r.Product }},
+	{header: "CYCLE", kind: textColumn, text: func(r displayRow) string { return r.Cycle }},
+	{header: "CODENAME", kind: textColumn, optional: true, text: func(r displayRow) string { return r.Codename }},
+	{header: "LABEL", kind: textColumn, optional: true, text: func(r displayRow) string { return r.ReleaseLabel }},
+	{header: "LATEST", kind: textColumn, text: func(r displayRow) string { return r.Latest }},
+	{header: "RELEASED", kind: dateColumn, date: func(r displayRow) (string, string) { return r.ReleasedRel, r.ReleasedRaw }},
+	{header: "SUPPORT", kind: dateColumn, date: func(r displayRow) (string, string) { return r.SupportRel, r.SupportRaw }},
+	{header: "EXT. SUPPORT", kind: dateColumn, optional: true, date: func(r displayRow) (string, string) {
+		return r.ExtSupportRel, r.ExtSupportRaw
+	}},
+	{header: "EOL", kind: dateColumn, date: func(r displayRow) (string, string) { return r.EOLRel, r.EOLRaw }},
+	{header: "DISCONTINUED", kind: dateColumn, optional: true, date: func(r displayRow) (string, string) {
+		return r.DiscontinuedRel, r.DiscontinuedRaw
+	}},
+	{header: "LTS", kind: ltsColumn},
+	{header: "STATUS", kind: textColumn, noTable: true, text: func(r displayRow) string { return r.Status.String() }},
+	{header: "LINK", kind: textColumn, optional: true, text: func(r displayRow) string { return r.Link }},
+}
+
+// key returns the name of the column used to select it, e.g. "ext-support"
+func (c column) key() string {
+	return strings.ReplaceAll(strings.ReplaceAll(strings.ToLower(c.header), ".", ""), " ", "-")
+}
+
+// ColumnNames lists the names accepted by Options.Columns in display order
+func ColumnNames() []string {
+	names := make([]string, len(allColumns))
+	for i, c := range allColumns {
+		names[i] = c.key()
+	}
+	return names
+}
+
+// ValidateColumns returns an error if a column name is unknown
+func ValidateColumns(names []string) error {
+	valid := ColumnNames()
+	for _, name := range names {
+		if !slices.Contains(valid, name) {
+			return fmt.Errorf("unknown column '%s' (available: %s)", name, strings.Join(valid, ", "))
+		}
+	}
+	return nil
+}
+
+// visibleColumns returns the columns to render for rows. If selected is not
+// empty, only the selected columns are shown; the PRODUCT column of
+// multi-product tables is always kept.
+func visibleColumns(rows []displayRow, selected []string) []column {
+	cols := make([]column, 0, len(allColumns))
+	for _, c := range allColumns {
+		if len(selected) > 0 && c.header != "PRODUCT" && !slices.Contains(selected, c.key()) {
+			continue
+		}
+		if !c.optional || c.hasValues(rows) {
+			cols = append(cols, c)
+		}
+	}
+	return cols
+}
+
+// hasValues returns true if any row has a non-empty cell in the column
+func (c column) hasValues(rows []displayRow) bool {
+	for _, r := range rows {
+		if c.raw(r) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// raw returns the machine-readable cell value
+func (c column) raw(r displayRow) string {
+	switch c.kind {
+	case dateColumn:
+		_, raw := c.date(r)
+		return raw
+	case ltsColumn:
+		if r.LTS {
+			return "true"
+		}
+		return "false"
+	default:
+		return c.text(r)
+	}
+}
+
+// display returns the human-readable cell value for document formats,
+// combining relative and raw dates with formatDate
+func (c column) display(r displayRow, formatDate func(rel, raw string) string) string {
+	switch c.kind {
+	case dateColumn:
+		return formatDate(c.date(r))
+	case ltsColumn:
+		if r.LTS {
+			return "✔"
+		}
+		return ""
+	default:
+		return c.text(r)
+	}
+}
+
+// headers returns the header titles of cols
+func headers(cols []column) []string {
+	h := make([]string, len(cols))
+	for i, c := range cols {
+		h[i] = c.header
+	}
+	return h
+}
+
+// dateOnly strips boolean raw values that have no date to display
+func dateOnly(raw string) string {
+	if raw == "true" || raw == "false" {
+		return ""
+	}
+	return raw
+}
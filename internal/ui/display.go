@@ -6,7 +6,10 @@ package ui
 import (
 	"encoding/csv"
 	"fmt"
+	"html"
+	"io"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,14 +21,14 @@ import (
 var (
 	headerStyle = lipgloss.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("212"))
+			Foreground(theme.Header)
 
 	dimStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240"))
+			Foreground(theme.Dim)
 
 	tableHeaderStyle = lipgloss.NewStyle().
 				Bold(true).
-				Foreground(lipgloss.Color("252"))
+				Foreground(theme.TableHeader)
 )
 
 // formatDuration formats a duration as "Xy Xm" or "Xm" or "Xd"
@@ -57,7 +60,7 @@ type relativeDate struct {
 }
 
 // combinedCell creates a single string with relative left-aligned and date right-aligned
-func combinedCell(rel relativeDate, relColor, dateColor lipgloss.Color, width int) string {
+func combinedCell(rel relativeDate, relColor, dateColor lipgloss.TerminalColor, width int) string {
 	if rel.relative == "" && rel.date == "" {
 		return ""
 	}
@@ -85,20 +88,20 @@ func combinedCell(rel relativeDate, relColor, dateColor lipgloss.Color, width in
 	return relStr + strings.Repeat(" ", padding) + dateStr
 }
 
-// formatRelease formats a release date
-func formatRelease(t time.Time) relativeDate {
+// formatRelease formats a release date relative to now
+func formatRelease(t, now time.Time) relativeDate {
 	if t.IsZero() {
 		return relativeDate{"", ""}
 	}
-	diff := time.Since(t)
+	diff := now.Sub(t)
 	return relativeDate{
 		relative: fmt.Sprintf("%s ago", formatDuration(diff)),
 		date:     t.Format("2006-01-02"),
 	}
 }
 
-// formatSupport formats a support end date
-func formatSupport(support api.EOLValue) relativeDate {
+// formatSupport formats a support end date relative to now
+func formatSupport(support api.EOLValue, now time.Time) relativeDate {
 	if support.IsBoolean {
 		if support.BoolValue {
 			// true = support is active
@@ -111,7 +114,6 @@ func formatSupport(support api.EOLValue) relativeDate {
 		return relativeDate{"", ""}
 	}
 
-	now := time.Now()
 	diff := support.DateValue.Sub(now)
 
 	if diff > 0 {
@@ -126,8 +128,8 @@ func formatSupport(support api.EOLValue) relativeDate {
 	}
 }
 
-// formatEOL formats an EOL date
-func formatEOL(eol api.EOLValue) relativeDate {
+// formatEOL formats an EOL date relative to now
+func formatEOL(eol api.EOLValue, now time.Time) relativeDate {
 	if eol.IsBoolean {
 		if eol.BoolValue {
 			return relativeDate{"Ended", ""}
@@ -138,7 +140,6 @@ func formatEOL(eol api.EOLValue) relativeDate {
 		return relativeDate{"", ""}
 	}
 
-	now := time.Now()
 	diff := eol.DateValue.Sub(now)
 
 	if diff > 0 {
@@ -153,43 +154,76 @@ func formatEOL(eol api.EOLValue) relativeDate {
 	}
 }
 
+// formatDiscontinued formats a discontinuation date or flag
+func formatDiscontinued(v api.EOLValue, now time.Time) relativeDate {
+	if v.IsBoolean {
+		if v.BoolValue {
+			return relativeDate{"Yes", ""}
+		}
+		return relativeDate{"No", ""}
+	}
+	return formatEOL(v, now)
+}
+
 // displayRow holds processed row data for output formatting
 type displayRow struct {
-	Cycle       string
-	Latest      string
-	ReleasedRel string // relative format (e.g., "3m ago")
-	ReleasedRaw string // raw date (e.g., "2025-10-07")
-	SupportRel  string // relative format
-	SupportRaw  string // raw date or boolean as string
-	EOLRel      string // relative format
-	EOLRaw      string // raw date or boolean as string
-	LTS         bool
-	IsEOL       bool
+	Product         string // only set when several products share a table
+	Cycle           string
+	Codename        string
+	ReleaseLabel    string
+	Latest          string
+	Link            string
+	ReleasedRel     string // relative format (e.g., "3m ago")
+	ReleasedRaw     string // raw date (e.g., "2025-10-07")
+	SupportRel      string // relative format
+	SupportRaw      string // raw date or boolean as string
+	ExtSupportRel   string // relative format
+	ExtSupportRaw   string // raw date or boolean as string
+	EOLRel          string // relative format
+	EOLRaw          string // raw date or boolean as string
+	DiscontinuedRel string // relative format
+	DiscontinuedRaw string // raw date or boolean as string
+	LTS             bool
+	Status          api.Status
 }
 
-// prepareDisplayRows converts cycles to displayRow slice
-func prepareDisplayRows(cycles []api.Cycle, showAll bool) []displayRow {
+// prepareDisplayRows converts cycles to displayRow slice as seen at now;
+// cycles ending within warnDays are marked as expiring
+func prepareDisplayRows(cycles []api.Cycle, showAll bool, warnDays int, now time.Time) []displayRow {
 	var rows []displayRow
 	for _, c := range cycles {
-		if !showAll && c.EOL.IsEOL() {
+		if !showAll && c.EOL.IsEOLAt(now) {
 			continue
 		}
 
-		release := formatRelease(c.ReleaseDate.Time)
-		support := formatSupport(c.Support)
-		eol := formatEOL(c.EOL)
+		release := formatRelease(c.ReleaseDate.Time, now)
+		support := formatSupport(c.Support, now)
+		extSupport := formatSupport(c.ExtendedSupport, now)
+		eol := formatEOL(c.EOL, now)
+		discontinued := formatDiscontinued(c.Discontinued, now)
 
 		row := displayRow{
-			Cycle:       c.Cycle,
-			Latest:      c.Latest,
-			ReleasedRel: release.relative,
-			ReleasedRaw: release.date,
-			SupportRel:  support.relative,
-			SupportRaw:  formatRawValue(c.Support),
-			EOLRel:      eol.relative,
-			EOLRaw:      formatRawValue(c.EOL),
-			LTS:         c.LTS.IsLTS(),
-			IsEOL:       c.EOL.IsEOL(),
+			Cycle:        c.Cycle,
+			Codename:     c.Codename,
+			ReleaseLabel: c.ReleaseLabel,
+			Latest:       c.Latest,
+			Link:         c.Link,
+			ReleasedRel:  release.relative,
+			ReleasedRaw:  release.date,
+			SupportRel:   support.relative,
+			SupportRaw:   formatRawValue(c.Support),
+			EOLRel:       eol.relative,
+			EOLRaw:       formatRawValue(c.EOL),
+			LTS:          c.LTS.IsLTS(),
+			Status:       c.StatusAt(now, warnDays),
+		}
+		if c.ExtendedSupport.IsSet() {
+			row.ExtSupportRel = extSupport.relative
+			row.ExtSupportRaw = formatRawValue(c.ExtendedSupport)
+		}
+		if c.Discontinued.IsSet() {
+			row.DiscontinuedRel = discontinued.relative
+			row.DiscontinuedRaw = formatRawValue(c.Discontinued)
 		}
 		rows = append(rows, row)
 	}
@@ -210,124 +244,184 @@ func formatRawValue(v api.EOLValue) string {
 	return v.DateValue.Format("2006-01-02")
 }
 
-// DisplayCycles prints the release cycles in the specified format
-func DisplayCycles(product string, cycles []api.Cycle, showAll bool, format string) {
-	rows := prepareDisplayRows(cycles, showAll)
+// Options controls how DisplayCycles renders release cycles
+type Options struct {
+	// SnapshotDate is the creation time of the offline snapshot the data comes from
+	SnapshotDate time.Time
+	// Format is one of Formats
+	Format string
+	// AlarmDays lists how many days before each calendar event to remind (ics format)
+	AlarmDays []int
+	// WarnDays is the window in days before the end of life or of active
+	// support in which a cycle is shown as expiring
+	WarnDays int
+	// Columns selects the table columns by name (see ColumnNames); all
+	// columns are shown if empty
+	Columns []string
+	// ShowAll includes cycles that reached their end of life
+	ShowAll bool
+	// Combined renders several products as one table with a PRODUCT column
+	// instead of one section per product
+	Combined bool
+	// Clock is the time dates are evaluated at; the system clock if nil
+	Clock api.Clock
+}
 
-	if len(rows) == 0 {
-		if showAll {
-			fmt.Println("No release cycles found for", product)
-		} else {
-			fmt.Println("No active release cycles found for", product)
-			fmt.Println(dimStyle.Render("Use --all to show end-of-life versions"))
-		}
+// now returns the time of the options' clock
+func (o Options) now() time.Time {
+	if o.Clock == nil {
+		return api.SystemClock()
+	}
+	return o.Clock()
+}
+
+// Formats lists the output formats supported by DisplayCycles
+var Formats = []string{"table", "markdown", "csv", "html", "json", "ndjson", "yaml", "toml", "ics", "openmetrics"}
+
+// ValidateFormat returns an error listing the valid formats if format is unknown
+func ValidateFormat(format string) error {
+	if slices.Contains(Formats, format) {
+		return nil
+	}
+	return fmt.Errorf("unknown format '%s' (valid formats: %s)", format, strings.Join(Formats, ", "))
+}
+
+// printNoCycles reports that no cycles of product are shown, hinting at
+// --all unless it is already given
+func printNoCycles(product string, showAll bool) {
+	if showAll {
+		fmt.Println("No release cycles found for", product)
 		return
 	}
+	fmt.Println("No active release cycles found for", product)
+	fmt.Println(dimStyle.Render("Use --all to show end-of-life versions"))
+}
+
+// DisplayCycles prints the release cycles in the format given by opts
+func DisplayCycles(product string, cycles []api.Cycle, opts Options) error {
+	if err := ValidateFormat(opts.Format); err != nil {
+		return err
+	}
+
+	now := opts.now()
+
+	// Structured formats always produce a document, even without cycles
+	switch opts.Format {
+	case "json":
+		return WriteJSON(os.Stdout, product, cycles, opts, now)
+	case "ndjson":
+		return formatAsNDJSON(os.Stdout, product, cycles, opts, now)
+	case "yaml":
+		return formatAsYAML(os.Stdout, product, cycles, opts, now)
+	case "toml":
+		return formatAsTOML(os.Stdout, product, cycles, opts, now)
+	case "ics":
+		return WriteCalendar(os.Stdout, []ProductCycles{{Product: product, Cycles: cycles}}, opts, time.Now(), now)
+	case "openmetrics":
+		// The data was fetched just now, even when evaluated at --as-of
+		return WriteOpenMetrics(os.Stdout, []ProductCycles{{Product: product, Cycles: cycles}}, time.Now(), now)
+	}
+
+	rows := prepareDisplayRows(cycles, opts.ShowAll, opts.WarnDays, now)
+
+	if len(rows) == 0 {
+		printNoCycles(product, opts.ShowAll)
+		return nil
+	}
 
-	switch format {
+	switch opts.Format {
 	case "markdown":
-		formatAsMarkdown(product, rows)
+		formatAsMarkdown(product, rows, opts.Columns)
 	case "csv":
-		formatAsCSV(rows)
+		formatAsCSV(rows, opts.Columns)
 	case "html":
-		formatAsHTML(product, rows)
+		formatAsHTML(os.Stdout, product, rows, opts.Columns)
 	default:
-		formatAsTable(product, cycles, rows, showAll)
+		formatAsTable(product, cycles, rows, opts)
 	}
+	return nil
+}
+
+// statusColor returns the table color of a cycle status
+func statusColor(s api.Status) lipgloss.TerminalColor {
+	switch s {
+	case api.StatusEOL:
+		return theme.EOL
+	case api.StatusExpiring:
+		return theme.Warn
+	case api.StatusActive, api.StatusSecurityOnly:
+	}
+	return theme.Active
 }
 
 // formatAsTable renders the lipgloss table (original format)
-func formatAsTable(product string, cycles []api.Cycle, rows []displayRow, showAll bool) {
+func formatAsTable(product string, cycles []api.Cycle, rows []displayRow, opts Options) {
 	fmt.Println()
 	fmt.Println(headerStyle.Render(fmt.Sprintf("Release cycles for %s", product)))
 	fmt.Println()
 
+	// The table shows the status through colors
+	cols := slices.DeleteFunc(visibleColumns(rows, opts.Columns), func(c column) bool { return c.noTable })
+
 	// Calculate column widths for combined cells
-	releasedWidth, supportWidth, eolWidth := 0, 0, 0
-	for _, r := range rows {
-		if w := len(r.ReleasedRel) + 1 + len(r.ReleasedRaw); w > releasedWidth {
-			releasedWidth = w
-		}
-		supportDisplay := r.SupportRel
-		if supportDisplay != "" && r.SupportRaw != "" && r.SupportRaw != "true" && r.SupportRaw != "false" {
-			if w := len(r.SupportRel) + 1 + len(r.SupportRaw); w > supportWidth {
-				supportWidth = w
-			}
-		} else if len(supportDisplay) > supportWidth {
-			supportWidth = len(supportDisplay)
+	widths := make([]int, len(cols))
+	for i, c := range cols {
+		if c.kind != dateColumn {
+			continue
 		}
-		eolDisplay := r.EOLRel
-		if eolDisplay != "" && r.EOLRaw != "" && r.EOLRaw != "true" && r.EOLRaw != "false" {
-			if w := len(r.EOLRel) + 1 + len(r.EOLRaw); w > eolWidth {
-				eolWidth = w
+		for _, r := range rows {
+			rel, raw := c.date(r)
+			w := len(rel)
+			if date := dateOnly(raw); rel != "" && date != "" {
+				w += 1 + len(date)
 			}
-		} else if len(eolDisplay) > eolWidth {
-			eolWidth = len(eolDisplay)
+			widths[i] = max(widths[i], w)
 		}
 	}
 
-	dimColor := lipgloss.Color("240")
 	tableRows := make([][]string, 0, len(rows))
 	for _, r := range rows {
-		rowColor := lipgloss.Color("42") // green
-		if r.IsEOL {
-			rowColor = lipgloss.Color("203") // red
-		}
-
-		releaseRel := relativeDate{r.ReleasedRel, r.ReleasedRaw}
-		supportDate := r.SupportRaw
-		if supportDate == "true" || supportDate == "false" {
-			supportDate = ""
-		}
-		supportRel := relativeDate{r.SupportRel, supportDate}
-		eolDate := r.EOLRaw
-		if eolDate == "true" || eolDate == "false" {
-			eolDate = ""
-		}
-		eolRel := relativeDate{r.EOLRel, eolDate}
-
-		ltsStr := ""
-		if r.LTS {
-			ltsStr = "✔"
+		rowColor := statusColor(r.Status)
+
+		cells := make([]string, len(cols))
+		for i, c := range cols {
+			switch c.kind {
+			case dateColumn:
+				rel, raw := c.date(r)
+				cells[i] = combinedCell(relativeDate{rel, dateOnly(raw)}, rowColor, theme.Dim, widths[i])
+			case ltsColumn:
+				cells[i] = c.display(r, formatMarkdownDate)
+			default:
+				cells[i] = c.text(r)
+			}
 		}
-
-		tableRows = append(tableRows, []string{
-			r.Cycle,
-			r.Latest,
-			combinedCell(releaseRel, rowColor, dimColor, releasedWidth),
-			combinedCell(supportRel, rowColor, dimColor, supportWidth),
-			combinedCell(eolRel, rowColor, dimColor, eolWidth),
-			ltsStr,
-		})
+		tableRows = append(tableRows, cells)
 	}
 
 	t := table.New().
 		Border(lipgloss.RoundedBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("240"))).
-		Headers("CYCLE", "LATEST", "RELEASED", "SUPPORT", "EOL", "LTS").
+		BorderStyle(lipgloss.NewStyle().Foreground(theme.Dim)).
+		Headers(headers(cols)...).
 		Rows(tableRows...).
 		StyleFunc(func(row, col int) lipgloss.Style {
 			baseStyle := lipgloss.NewStyle().Padding(0, 1)
+			isLTS := cols[col].kind == ltsColumn
 
-			if col == 5 {
+			if isLTS {
 				baseStyle = baseStyle.Align(lipgloss.Center)
 			}
 
 			if row == table.HeaderRow {
-				if col == 5 {
+				if isLTS {
 					return tableHeaderStyle.Padding(0, 1).Align(lipgloss.Center)
 				}
 				return tableHeaderStyle.Padding(0, 1)
 			}
 
-			if rows[row].IsEOL {
-				baseStyle = baseStyle.Foreground(lipgloss.Color("203"))
-			} else {
-				baseStyle = baseStyle.Foreground(lipgloss.Color("42"))
-			}
+			baseStyle = baseStyle.Foreground(statusColor(rows[row].Status))
 
-			if col == 5 && rows[row].LTS {
-				return baseStyle.Foreground(lipgloss.Color("220"))
+			if isLTS && rows[row].LTS {
+				return baseStyle.Foreground(theme.Warn)
 			}
 
 			return baseStyle
@@ -336,42 +430,59 @@ func formatAsTable(product string, cycles []api.Cycle, rows []displayRow, showAl
 	fmt.Println(t.Render())
 	fmt.Println()
 
+	now := opts.now()
 	activeCount := 0
+	expiringCount := 0
 	eolCount := 0
 	for _, c := range cycles {
-		if c.EOL.IsEOL() {
+		switch c.StatusAt(now, opts.WarnDays) {
+		case api.StatusEOL:
 			eolCount++
-		} else {
+		case api.StatusExpiring:
+			expiringCount++
+		case api.StatusActive, api.StatusSecurityOnly:
 			activeCount++
 		}
 	}
 
-	summary := fmt.Sprintf("%d active", activeCount)
-	if eolCount > 0 && !showAll {
+	summary := dimStyle.Render(fmt.Sprintf("%d active", activeCount))
+	if expiringCount > 0 {
+		summary += dimStyle.Render(", ") +
+			lipgloss.NewStyle().Foreground(theme.Warn).Render(fmt.Sprintf("%d expiring within %d days", expiringCount, opts.WarnDays))
+	}
+	if eolCount > 0 && !opts.ShowAll {
 		summary += dimStyle.Render(fmt.Sprintf(", %d EOL (use --all to show)", eolCount))
 	} else if eolCount > 0 {
-		summary += fmt.Sprintf(", %d EOL", eolCount)
+		summary += dimStyle.Render(fmt.Sprintf(", %d EOL", eolCount))
+	}
+	fmt.Println(summary)
+
+	// The age of the snapshot is real time, independent of opts.Clock
+	if !opts.SnapshotDate.IsZero() {
+		fmt.Println(dimStyle.Render(fmt.Sprintf("Offline data from snapshot created %s (%s ago)",
+			opts.SnapshotDate.Format("2006-01-02"), formatDuration(time.Since(opts.SnapshotDate)))))
 	}
-	fmt.Println(dimStyle.Render(summary))
 }
 
 // formatAsMarkdown renders a Markdown table
-func formatAsMarkdown(product string, rows []displayRow) {
+func formatAsMarkdown(product string, rows []displayRow, columns []string) {
+	cols := visibleColumns(rows, columns)
+
 	fmt.Printf("# Release cycles for %s\n\n", product)
-	fmt.Println("| CYCLE | LATEST | RELEASED | SUPPORT | EOL | LTS |")
-	fmt.Println("|-------|--------|----------|---------|-----|-----|")
+
+	separators := make([]string, len(cols))
+	for i, c := range cols {
+		separators[i] = strings.Repeat("-", len(c.header)+2)
+	}
+	fmt.Printf("| %s |\n", strings.Join(headers(cols), " | "))
+	fmt.Printf("|%s|\n", strings.Join(separators, "|"))
 
 	for _, r := range rows {
-		released := formatMarkdownDate(r.ReleasedRel, r.ReleasedRaw)
-		support := formatMarkdownDate(r.SupportRel, r.SupportRaw)
-		eol := formatMarkdownDate(r.EOLRel, r.EOLRaw)
-		lts := ""
-		if r.LTS {
-			lts = "✔"
+		cells := make([]string, len(cols))
+		for i, c := range cols {
+			cells[i] = c.display(r, formatMarkdownDate)
 		}
-
-		fmt.Printf("| %s | %s | %s | %s | %s | %s |\n",
-			r.Cycle, r.Latest, released, support, eol, lts)
+		fmt.Printf("| %s |\n", strings.Join(cells, " | "))
 	}
 }
 
@@ -390,57 +501,54 @@ func formatMarkdownDate(rel, raw string) string {
 }
 
 // formatAsCSV renders CSV output
-func formatAsCSV(rows []displayRow) {
+func formatAsCSV(rows []displayRow, columns []string) {
+	cols := visibleColumns(rows, columns)
+
 	w := csv.NewWriter(os.Stdout)
 	defer w.Flush()
 
-	_ = w.Write([]string{"CYCLE", "LATEST", "RELEASED", "SUPPORT", "EOL", "LTS"})
+	_ = w.Write(headers(cols))
 
 	for _, r := range rows {
-		lts := "false"
-		if r.LTS {
-			lts = "true"
+		record := make([]string, len(cols))
+		for i, c := range cols {
+			record[i] = c.raw(r)
 		}
-		_ = w.Write([]string{
-			r.Cycle,
-			r.Latest,
-			r.ReleasedRaw,
-			r.SupportRaw,
-			r.EOLRaw,
-			lts,
-		})
+		_ = w.Write(record)
 	}
 }
 
 // formatAsHTML renders an HTML table
-func formatAsHTML(product string, rows []displayRow) {
-	fmt.Printf("<h1>Release cycles for %s</h1>\n", product)
-	fmt.Println("<table>")
-	fmt.Println("  <thead>")
-	fmt.Println("    <tr><th>CYCLE</th><th>LATEST</th><th>RELEASED</th><th>SUPPORT</th><th>EOL</th><th>LTS</th></tr>")
-	fmt.Println("  </thead>")
-	fmt.Println("  <tbody>")
+func formatAsHTML(w io.Writer, product string, rows []displayRow, columns []string) {
+	cols := visibleColumns(rows, columns)
+
+	fmt.Fprintf(w, "<h1>Release cycles for %s</h1>\n", html.EscapeString(product))
+	fmt.Fprintln(w, "<table>")
+	fmt.Fprintln(w, "  <thead>")
+	fmt.Fprintf(w, "    <tr><th>%s</th></tr>\n", strings.Join(headers(cols), "</th><th>"))
+	fmt.Fprintln(w, "  </thead>")
+	fmt.Fprintln(w, "  <tbody>")
 
 	for _, r := range rows {
 		color := "green"
-		if r.IsEOL {
+		switch r.Status {
+		case api.StatusEOL:
 			color = "red"
+		case api.StatusExpiring:
+			color = "darkorange"
+		case api.StatusActive, api.StatusSecurityOnly:
 		}
 
-		released := formatHTMLDate(r.ReleasedRel, r.ReleasedRaw)
-		support := formatHTMLDate(r.SupportRel, r.SupportRaw)
-		eol := formatHTMLDate(r.EOLRel, r.EOLRaw)
-		lts := ""
-		if r.LTS {
-			lts = "✔"
+		cells := make([]string, len(cols))
+		for i, c := range cols {
+			cells[i] = html.EscapeString(c.display(r, formatHTMLDate))
 		}
 
-		fmt.Printf("    <tr style=\"color: %s;\"><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
-			color, r.Cycle, r.Latest, released, support, eol, lts)
+		fmt.Fprintf(w, "    <tr style=\"color: %s;\"><td>%s</td></tr>\n", color, strings.Join(cells, "</td><td>"))
 	}
 
-	fmt.Println("  </tbody>")
-	fmt.Println("</table>")
+	fmt.Fprintln(w, "  </tbody>")
+	fmt.Fprintln(w, "</table>")
 }
 
 // formatHTMLDate combines relative and raw date for HTML output
@@ -43,7 +43,7 @@ func TestFormatDuration(t *testing.T) {
 }
 
 func TestFormatRelease(t *testing.T) {
-	now := time.Now()
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name         string
@@ -73,7 +73,7 @@ func TestFormatRelease(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := formatRelease(tt.releaseTime)
+			got := formatRelease(tt.releaseTime, now)
 			if got.relative != tt.wantRelative {
 				t.Errorf("formatRelease().relative = %q, want %q", got.relative, tt.wantRelative)
 			}
@@ -85,7 +85,7 @@ func TestFormatRelease(t *testing.T) {
 }
 
 func TestFormatSupport(t *testing.T) {
-	now := time.Now()
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
 	futureDate := now.AddDate(1, 6, 0)
 	pastDate := now.AddDate(-1, -3, 0)
 
@@ -129,7 +129,7 @@ func TestFormatSupport(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := formatSupport(tt.support)
+			got := formatSupport(tt.support, now)
 			if got.relative != tt.wantRelative {
 				t.Errorf("formatSupport().relative = %q, want %q", got.relative, tt.wantRelative)
 			}
@@ -144,7 +144,7 @@ func TestFormatSupport(t *testing.T) {
 }
 
 func TestFormatEOL(t *testing.T) {
-	now := time.Now()
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
 	futureDate := now.AddDate(2, 0, 0)
 	pastDate := now.AddDate(0, -6, 0)
 
@@ -182,7 +182,7 @@ func TestFormatEOL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := formatEOL(tt.eol)
+			got := formatEOL(tt.eol, now)
 			if got.relative != tt.wantRelative {
 				t.Errorf("formatEOL().relative = %q, want %q", got.relative, tt.wantRelative)
 			}
@@ -240,8 +240,9 @@ func TestCombinedCell(t *testing.T) {
 }
 
 func TestPrepareDisplayRows(t *testing.T) {
-	futureDate := time.Now().AddDate(2, 0, 0)
-	pastDate := time.Now().AddDate(-1, 0, 0)
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	futureDate := now.AddDate(2, 0, 0)
+	pastDate := now.AddDate(-1, 0, 0)
 
 	cycles := []api.Cycle{
 		{
@@ -263,7 +264,7 @@ func TestPrepareDisplayRows(t *testing.T) {
 	}
 
 	t.Run("showAll=false filters EOL", func(t *testing.T) {
-		rows := prepareDisplayRows(cycles, false)
+		rows := prepareDisplayRows(cycles, false, 0, now)
 		if len(rows) != 1 {
 			t.Errorf("expected 1 row, got %d", len(rows))
 		}
@@ -273,14 +274,14 @@ func TestPrepareDisplayRows(t *testing.T) {
 	})
 
 	t.Run("showAll=true includes all", func(t *testing.T) {
-		rows := prepareDisplayRows(cycles, true)
+		rows := prepareDisplayRows(cycles, true, 0, now)
 		if len(rows) != 2 {
 			t.Errorf("expected 2 rows, got %d", len(rows))
 		}
 	})
 
 	t.Run("LTS flag is set correctly", func(t *testing.T) {
-		rows := prepareDisplayRows(cycles, true)
+		rows := prepareDisplayRows(cycles, true, 0, now)
 		if !rows[0].LTS {
 			t.Error("expected LTS=true for cycle 1.0")
 		}
@@ -381,7 +382,6 @@ func TestFormatAsCSV(t *testing.T) {
 			EOLRel:      "in 4y 10m",
 			EOLRaw:      "2030-10-31",
 			LTS:         false,
-			IsEOL:       false,
 		},
 		{
 			Cycle:       "3.13",
@@ -393,12 +393,11 @@ func TestFormatAsCSV(t *testing.T) {
 			EOLRel:      "in 3y 10m",
 			EOLRaw:      "2029-10-31",
 			LTS:         true,
-			IsEOL:       false,
 		},
 	}
 
 	output := captureStdout(func() {
-		formatAsCSV(rows)
+		formatAsCSV(rows, nil)
 	})
 
 	// Check header
@@ -429,12 +428,11 @@ func TestFormatAsMarkdown(t *testing.T) {
 			EOLRel:      "in 4y 10m",
 			EOLRaw:      "2030-10-31",
 			LTS:         false,
-			IsEOL:       false,
 		},
 	}
 
 	output := captureStdout(func() {
-		formatAsMarkdown("python", rows)
+		formatAsMarkdown("python", rows, nil)
 	})
 
 	// Check header
@@ -470,7 +468,6 @@ func TestFormatAsHTML(t *testing.T) {
 			EOLRel:      "in 4y 10m",
 			EOLRaw:      "2030-10-31",
 			LTS:         true,
-			IsEOL:       false,
 		},
 		{
 			Cycle:       "2.7",
@@ -482,12 +479,12 @@ func TestFormatAsHTML(t *testing.T) {
 			EOLRel:      "4y ago",
 			EOLRaw:      "2020-01-01",
 			LTS:         false,
-			IsEOL:       true,
+			Status:      api.StatusEOL,
 		},
 	}
 
 	output := captureStdout(func() {
-		formatAsHTML("python", rows)
+		formatAsHTML(os.Stdout, "python", rows, nil)
 	})
 
 	// Check structure
@@ -519,3 +516,171 @@ func TestFormatAsHTML(t *testing.T) {
 		t.Error("HTML output missing LTS checkmark")
 	}
 }
+
+func TestVisibleColumns(t *testing.T) {
+	base := displayRow{Cycle: "3.14", Latest: "3.14.2", EOLRaw: "2030-10-31"}
+
+	t.Run("default columns", func(t *testing.T) {
+		got := strings.Join(headers(visibleColumns([]displayRow{base}, nil)), ",")
+		if want := "CYCLE,LATEST,RELEASED,SUPPORT,EOL,LTS,STATUS"; got != want {
+			t.Errorf("visibleColumns() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("optional columns", func(t *testing.T) {
+		extra := base
+		extra.Codename = "Noble Numbat"
+		extra.ExtSupportRaw = "2036-04-30"
+		extra.Link = "https://example.com"
+
+		got := strings.Join(headers(visibleColumns([]displayRow{base, extra}, nil)), ",")
+		if want := "CYCLE,CODENAME,LATEST,RELEASED,SUPPORT,EXT. SUPPORT,EOL,LTS,STATUS,LINK"; got != want {
+			t.Errorf("visibleColumns() = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("selected columns", func(t *testing.T) {
+		extra := base
+		extra.Product = "python"
+		extra.ExtSupportRaw = "2036-04-30"
+
+		got := strings.Join(headers(visibleColumns([]displayRow{extra}, []string{"eol", "cycle", "ext-support", "codename"})), ",")
+		if want := "PRODUCT,CYCLE,EXT. SUPPORT,EOL"; got != want {
+			t.Errorf("visibleColumns() = %s, want %s", got, want)
+		}
+	})
+}
+
+func TestValidateColumns(t *testing.T) {
+	if err := ValidateColumns([]string{"cycle", "ext-support", "lts"}); err != nil {
+		t.Errorf("ValidateColumns() error = %v", err)
+	}
+	if err := ValidateColumns([]string{"cycle", "EOL"}); err == nil {
+		t.Error("ValidateColumns() accepted unknown column EOL")
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	defer func() { _ = SetTheme("dark") }()
+
+	if err := SetTheme("solarized"); err == nil {
+		t.Error("SetTheme() accepted unknown theme")
+	}
+	if err := SetTheme("none"); err != nil {
+		t.Fatalf("SetTheme() error = %v", err)
+	}
+	if levelColor(LevelEOL) != (lipgloss.NoColor{}) {
+		t.Errorf("levelColor() with theme none = %v, want no color", levelColor(LevelEOL))
+	}
+}
+
+func TestPrepareDisplayRows_OptionalFields(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	cycles := []api.Cycle{
+		{
+			Cycle:           "24.04",
+			Codename:        "Noble Numbat",
+			ReleaseLabel:    "24.04 LTS",
+			Link:            "https://wiki.ubuntu.com/NobleNumbat/ReleaseNotes",
+			EOL:             api.EOLValue{DateValue: now.AddDate(3, 0, 0)},
+			ExtendedSupport: api.EOLValue{DateValue: time.Date(2036, 4, 30, 0, 0, 0, 0, time.UTC)},
+			Discontinued:    api.EOLValue{IsBoolean: true, BoolValue: false},
+		},
+		{
+			Cycle: "23.10",
+			EOL:   api.EOLValue{DateValue: now.AddDate(1, 0, 0)},
+		},
+	}
+
+	rows := prepareDisplayRows(cycles, true, 0, now)
+
+	if rows[0].Codename != "Noble Numbat" || rows[0].ReleaseLabel != "24.04 LTS" {
+		t.Errorf("row = %+v, want codename and label", rows[0])
+	}
+	if rows[0].ExtSupportRaw != "2036-04-30" {
+		t.Errorf("ExtSupportRaw = %q, want 2036-04-30", rows[0].ExtSupportRaw)
+	}
+	if rows[0].DiscontinuedRel != "No" || rows[0].DiscontinuedRaw != "false" {
+		t.Errorf("Discontinued = %q/%q, want No/false", rows[0].DiscontinuedRel, rows[0].DiscontinuedRaw)
+	}
+	if rows[1].ExtSupportRel != "" || rows[1].DiscontinuedRel != "" {
+		t.Errorf("row without optional fields = %+v, want empty optional cells", rows[1])
+	}
+}
+
+func TestFormatAsCSV_OptionalColumns(t *testing.T) {
+	rows := []displayRow{
+		{
+			Cycle:           "10-22h2",
+			ReleaseLabel:    "10 22H2",
+			Latest:          "10.0.19045",
+			ReleasedRaw:     "2022-10-18",
+			SupportRaw:      "2025-10-14",
+			ExtSupportRaw:   "2028-10-10",
+			EOLRaw:          "2025-10-14",
+			DiscontinuedRaw: "true",
+		},
+	}
+
+	output := captureStdout(func() {
+		formatAsCSV(rows, nil)
+	})
+
+	if !strings.Contains(output, "CYCLE,LABEL,LATEST,RELEASED,SUPPORT,EXT. SUPPORT,EOL,DISCONTINUED,LTS") {
+		t.Errorf("CSV output missing optional headers:\n%s", output)
+	}
+	if !strings.Contains(output, "10-22h2,10 22H2,10.0.19045,2022-10-18,2025-10-14,2028-10-10,2025-10-14,true,false") {
+		t.Errorf("CSV output missing optional values:\n%s", output)
+	}
+}
+
+func TestReportAsCSV(t *testing.T) {
+	entries := []ReportEntry{
+		{
+			Product: "python",
+			Version: "3.11.4",
+			Source:  ".python-version:1",
+			Cycle: api.Cycle{
+				Cycle:  "3.11",
+				Latest: "3.11.9",
+				EOL:    api.EOLValue{DateValue: time.Date(2027, 10, 31, 0, 0, 0, 0, time.UTC)},
+			},
+			Status: "outdated patch",
+			Level:  LevelWarn,
+		},
+		{Product: "terraform", Version: "1.7.5", Source: ".tool-versions:4", Status: "no matching cycle", Level: LevelUnknown},
+	}
+
+	output := captureStdout(func() {
+		reportAsCSV(entries, time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC))
+	})
+
+	want := "PRODUCT,VERSION,CYCLE,LATEST,EOL,STATUS,SOURCE\n" +
+		"python,3.11.4,3.11,3.11.9,2027-10-31,outdated patch,.python-version:1\n" +
+		"terraform,1.7.5,,,,no matching cycle,.tool-versions:4\n"
+	if output != want {
+		t.Errorf("reportAsCSV() = %q, want %q", output, want)
+	}
+}
+
+func TestReportAsMarkdown(t *testing.T) {
+	entries := []ReportEntry{
+		{Product: "go", Version: "1.22.3", Source: "go.mod:3", Cycle: api.Cycle{Cycle: "1.22", EOL: api.EOLValue{IsBoolean: true, BoolValue: true}}, Status: "EOL", Level: LevelEOL},
+		{Product: "python", Version: "3.12.1", Source: ".python-version:1", Cycle: api.Cycle{Cycle: "3.12", EOL: api.EOLValue{DateValue: time.Date(2028, 10, 31, 0, 0, 0, 0, time.UTC)}}, Status: "supported", Level: LevelOK},
+	}
+
+	output := captureStdout(func() {
+		reportAsMarkdown("project", entries, time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC))
+	})
+
+	if !strings.Contains(output, "# EOL report for project") {
+		t.Error("Markdown report missing title")
+	}
+	if !strings.Contains(output, "| go | 1.22.3 | 1.22 |  | Ended | EOL | go.mod:3 |") {
+		t.Errorf("Markdown report missing data row:\n%s", output)
+	}
+	if !strings.Contains(output, "| python | 3.12.1 | 3.12 |  | in 2y 9m (2028-10-31) | supported | .python-version:1 |") {
+		t.Errorf("Markdown report missing relative EOL date:\n%s", output)
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"maps"
+	"net/url"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// pageStyle is the stylesheet of the standalone HTML pages
+const pageStyle = `
+    body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 80rem; padding: 0 1rem; color: #222; }
+    h1 { font-size: 1.5rem; }
+    nav { margin-bottom: 1rem; }
+    nav a, ul a { color: #555; }
+    table { border-collapse: collapse; width: 100%; }
+    th, td { border-bottom: 1px solid #ddd; padding: 0.4rem 0.6rem; text-align: left; white-space: nowrap; }
+    th { background: #f5f5f5; font-size: 0.8rem; letter-spacing: 0.05em; }
+    tbody tr:hover { background: #fafafa; }
+    ul { columns: 16rem; list-style: none; padding: 0; }
+    footer { color: #888; font-size: 0.8rem; margin-top: 1rem; }`
+
+// writeHTMLPage wraps body in a complete, styled HTML document
+func writeHTMLPage(w io.Writer, title string, body func(w io.Writer)) error {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "<!DOCTYPE html>")
+	fmt.Fprintln(&b, `<html lang="en">`)
+	fmt.Fprintln(&b, "<head>")
+	fmt.Fprintln(&b, `  <meta charset="utf-8">`)
+	fmt.Fprintln(&b, `  <meta name="viewport" content="width=device-width, initial-scale=1">`)
+	fmt.Fprintf(&b, "  <title>%s</title>\n", html.EscapeString(title))
+	fmt.Fprintf(&b, "  <style>%s\n  </style>\n", pageStyle)
+	fmt.Fprintln(&b, "</head>")
+	fmt.Fprintln(&b, "<body>")
+	body(&b)
+	fmt.Fprintln(&b, "</body>")
+	fmt.Fprintln(&b, "</html>")
+
+	_, err := b.WriteTo(w)
+	return err
+}
+
+// WriteHTMLPage writes the release cycles of product as a standalone HTML
+// page, using the table of the html format. query holds the filters of the
+// request, which the link to the end-of-life cycles keeps.
+func WriteHTMLPage(w io.Writer, product string, cycles []api.Cycle, opts Options, query url.Values) error {
+	return writeHTMLPage(w, fmt.Sprintf("%s – eol-date", product), func(w io.Writer) {
+		fmt.Fprintln(w, `<nav><a href="/">All products</a></nav>`)
+
+		rows := prepareDisplayRows(cycles, opts.ShowAll, opts.WarnDays, opts.now())
+		if len(rows) == 0 {
+			fmt.Fprintf(w, "<h1>Release cycles for %s</h1>\n", html.EscapeString(product))
+			fmt.Fprintln(w, "<p>No matching release cycles.</p>")
+		} else {
+			formatAsHTML(w, product, rows, opts.Columns)
+		}
+
+		if !opts.ShowAll {
+			all := url.Values{}
+			maps.Copy(all, query)
+			all.Set("all", "true")
+			fmt.Fprintf(w, "<footer><a href=\"?%s\">Show end-of-life cycles</a></footer>\n", html.EscapeString(all.Encode()))
+		}
+	})
+}
+
+// WriteHTMLIndex writes a standalone HTML page linking to every product
+func WriteHTMLIndex(w io.Writer, products []string) error {
+	return writeHTMLPage(w, "eol-date", func(w io.Writer) {
+		fmt.Fprintln(w, "<h1>End-of-life dates</h1>")
+		fmt.Fprintln(w, "<ul>")
+		for _, p := range products {
+			fmt.Fprintf(w, "  <li><a href=\"/products/%s\">%s</a></li>\n", url.PathEscape(p), html.EscapeString(p))
+		}
+		fmt.Fprintln(w, "</ul>")
+		fmt.Fprintf(w, "<footer>%d products · data from <a href=\"https://endoflife.date\">endoflife.date</a></footer>\n", len(products))
+	})
+}
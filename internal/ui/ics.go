@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icsEvent is an all-day calendar event for a support or EOL date
+type icsEvent struct {
+	date    time.Time
+	uid     string
+	summary string
+	desc    string
+	link    string
+}
+
+// ValidateAlarmDays returns an error if a reminder is set after its event,
+// which would produce an invalid alarm trigger
+func ValidateAlarmDays(days []int) error {
+	for _, d := range days {
+		if d < 0 {
+			return fmt.Errorf("invalid --alarm-days %d: must not be negative", d)
+		}
+	}
+	return nil
+}
+
+// WriteCalendar writes an iCalendar (RFC 5545) file with one all-day event for
+// the end of active support and one for the end of life of every cycle.
+// Boolean values carry no date and are skipped. Each event gets an alarm
+// opts.AlarmDays days before it. created is the DTSTAMP of the events, while
+// now only selects the cycles and may differ, e.g. with --as-of.
+func WriteCalendar(w io.Writer, products []ProductCycles, opts Options, created, now time.Time) error {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(foldICSLine(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//eol-date//eol-date//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:End-of-life dates")
+
+	stamp := created.UTC().Format("20060102T150405Z")
+	for _, p := range products {
+		for _, e := range calendarEvents(p, opts, now) {
+			line("BEGIN:VEVENT")
+			line("UID:" + e.uid)
+			line("DTSTAMP:" + stamp)
+			line("DTSTART;VALUE=DATE:" + e.date.Format("20060102"))
+			line("DTEND;VALUE=DATE:" + e.date.AddDate(0, 0, 1).Format("20060102"))
+			line("SUMMARY:" + escapeICSText(e.summary))
+			line("DESCRIPTION:" + escapeICSText(e.desc))
+			if e.link != "" {
+				line("URL:" + e.link)
+			}
+			line("TRANSP:TRANSPARENT")
+			for _, days := range opts.AlarmDays {
+				line("BEGIN:VALARM")
+				line("ACTION:DISPLAY")
+				line(fmt.Sprintf("TRIGGER:-P%dD", days))
+				line("DESCRIPTION:" + escapeICSText(e.summary))
+				line("END:VALARM")
+			}
+			line("END:VEVENT")
+		}
+	}
+
+	line("END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// calendarEvents returns the events of a product, honoring opts.ShowAll
+func calendarEvents(p ProductCycles, opts Options, now time.Time) []icsEvent {
+	var events []icsEvent
+	for _, c := range p.Cycles {
+		if !opts.ShowAll && c.EOL.IsEOLAt(now) {
+			continue
+		}
+
+		desc := fmt.Sprintf("Product: %s\nCycle: %s", p.Product, c.Cycle)
+		if c.Latest != "" {
+			desc += "\nLatest: " + c.Latest
+		}
+
+		if !c.Support.IsBoolean && !c.Support.DateValue.IsZero() {
+			events = append(events, icsEvent{
+				date:    c.Support.DateValue,
+				uid:     icsUID(p.Product, c.Cycle, "support"),
+				summary: fmt.Sprintf("%s %s: end of active support", p.Product, c.Cycle),
+				desc:    desc,
+				link:    c.Link,
+			})
+		}
+		if !c.EOL.IsBoolean && !c.EOL.DateValue.IsZero() {
+			events = append(events, icsEvent{
+				date:    c.EOL.DateValue,
+				uid:     icsUID(p.Product, c.Cycle, "eol"),
+				summary: fmt.Sprintf("%s %s: end of life", p.Product, c.Cycle),
+				desc:    desc,
+				link:    c.Link,
+			})
+		}
+	}
+	return events
+}
+
+// icsUID returns a UID that stays the same across exports, so calendar
+// clients update events instead of duplicating them
+func icsUID(product, cycle, kind string) string {
+	return fmt.Sprintf("%s-%s-%s@eol-date", product, strings.ReplaceAll(cycle, " ", "_"), kind)
+}
+
+// escapeICSText escapes a TEXT value as required by RFC 5545
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// foldICSLine folds a content line into chunks of at most 75 octets without
+// splitting UTF-8 sequences; continuation lines start with a space
+func foldICSLine(s string) string {
+	const limit = 75
+	if len(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteCalendar(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	products := []ProductCycles{{Product: "python", Cycles: goldenCycles()}}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, products, Options{ShowAll: true, AlarmDays: []int{30, 7}}, now, now); err != nil {
+		t.Fatalf("WriteCalendar() error = %v", err)
+	}
+	assertGolden(t, "cycles.ics", buf.Bytes())
+}
+
+func TestWriteCalendar_SkipsBooleanValues(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	cycles := goldenCycles()[2:] // 2.7 only has boolean support and EOL values
+	products := []ProductCycles{{Product: "python", Cycles: cycles}}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, products, Options{ShowAll: true}, now, now); err != nil {
+		t.Fatalf("WriteCalendar() error = %v", err)
+	}
+	if strings.Contains(buf.String(), "BEGIN:VEVENT") {
+		t.Errorf("WriteCalendar() wrote events for boolean values:\n%s", buf.String())
+	}
+}
+
+func TestValidateAlarmDays(t *testing.T) {
+	if err := ValidateAlarmDays([]int{0, 30, 7}); err != nil {
+		t.Errorf("ValidateAlarmDays() error = %v", err)
+	}
+	if err := ValidateAlarmDays([]int{30, -5}); err == nil {
+		t.Error("ValidateAlarmDays() accepted -5")
+	}
+}
+
+func TestEscapeICSText(t *testing.T) {
+	got := escapeICSText("a,b;c\\d\ne")
+	want := `a\,b\;c\\d\ne`
+	if got != want {
+		t.Errorf("escapeICSText() = %q, want %q", got, want)
+	}
+}
+
+func TestFoldICSLine(t *testing.T) {
+	short := "SUMMARY:python 3.12: end of life"
+	if got := foldICSLine(short); got != short {
+		t.Errorf("foldICSLine(%q) = %q, want unchanged", short, got)
+	}
+
+	long := "DESCRIPTION:" + strings.Repeat("ä", 60)
+	got := foldICSLine(long)
+	for _, l := range strings.Split(got, "\r\n") {
+		if len(l) > 75 {
+			t.Errorf("folded line has %d octets, want at most 75: %q", len(l), l)
+		}
+	}
+	if unfolded := strings.ReplaceAll(got, "\r\n ", ""); unfolded != long {
+		t.Errorf("unfolded line = %q, want %q", unfolded, long)
+	}
+}
+
+func TestWriteCalendar_StampsCreationTime(t *testing.T) {
+	created := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	asOf := time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC)
+	products := []ProductCycles{{Product: "python", Cycles: goldenCycles()}}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, products, Options{ShowAll: true}, created, asOf); err != nil {
+		t.Fatalf("WriteCalendar() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "DTSTAMP:20260115T120000Z") || strings.Contains(buf.String(), "DTSTAMP:20270630") {
+		t.Errorf("WriteCalendar() does not stamp the events with the creation time:\n%s", buf.String())
+	}
+}
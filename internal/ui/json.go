@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// JSONSchemaVersion is the version of the json and ndjson output schema
+// documented in docs/json-output.md. It is increased on incompatible changes.
+const JSONSchemaVersion = 1
+
+// jsonCycle is a release cycle with its upstream values and computed fields
+type jsonCycle struct { //nolint:govet // field order defines the JSON key order
+	api.Cycle
+	IsEOL               bool       `json:"isEOL"`
+	DaysUntilEOL        *int       `json:"daysUntilEOL"`
+	DaysUntilSupportEnd *int       `json:"daysUntilSupportEnd"`
+	Status              api.Status `json:"status"`
+}
+
+// jsonDocument is the top-level object of the json format
+type jsonDocument struct { //nolint:govet // field order defines the JSON key order
+	SchemaVersion int         `json:"schemaVersion"`
+	Product       string      `json:"product"`
+	QueriedAt     time.Time   `json:"queriedAt"`
+	SnapshotDate  *time.Time  `json:"snapshotDate,omitempty"`
+	Cycles        []jsonCycle `json:"cycles"`
+}
+
+// jsonProduct is the cycles of one product in a multi-product document
+type jsonProduct struct { //nolint:govet // field order defines the JSON key order
+	Product string      `json:"product"`
+	Cycles  []jsonCycle `json:"cycles"`
+}
+
+// jsonMultiDocument is the top-level object of the json format for several products
+type jsonMultiDocument struct { //nolint:govet // field order defines the JSON key order
+	SchemaVersion int           `json:"schemaVersion"`
+	QueriedAt     time.Time     `json:"queriedAt"`
+	SnapshotDate  *time.Time    `json:"snapshotDate,omitempty"`
+	Products      []jsonProduct `json:"products"`
+}
+
+// ndjsonLine is one line of the ndjson format: a cycle plus its context
+type ndjsonLine struct { //nolint:govet // field order defines the JSON key order
+	SchemaVersion int       `json:"schemaVersion"`
+	Product       string    `json:"product"`
+	QueriedAt     time.Time `json:"queriedAt"`
+	jsonCycle
+}
+
+// newJSONCycle computes the derived fields of c at now
+func newJSONCycle(c api.Cycle, now time.Time, warnDays int) jsonCycle {
+	jc := jsonCycle{
+		Cycle:  c,
+		IsEOL:  c.EOL.IsEOLAt(now),
+		Status: c.StatusAt(now, warnDays),
+	}
+	if !c.EOL.IsBoolean && !c.EOL.DateValue.IsZero() {
+		days := api.DaysUntil(now, c.EOL.DateValue)
+		jc.DaysUntilEOL = &days
+	}
+	if !c.Support.IsBoolean && !c.Support.DateValue.IsZero() {
+		days := api.DaysUntil(now, c.Support.DateValue)
+		jc.DaysUntilSupportEnd = &days
+	}
+	return jc
+}
+
+// jsonCycles returns the cycles to output, honoring opts.ShowAll
+func jsonCycles(cycles []api.Cycle, opts Options, now time.Time) []jsonCycle {
+	out := make([]jsonCycle, 0, len(cycles))
+	for _, c := range cycles {
+		if !opts.ShowAll && c.EOL.IsEOLAt(now) {
+			continue
+		}
+		out = append(out, newJSONCycle(c, now, opts.WarnDays))
+	}
+	return out
+}
+
+// WriteJSON writes the cycles as a single indented JSON document
+func WriteJSON(w io.Writer, product string, cycles []api.Cycle, opts Options, now time.Time) error {
+	doc := jsonDocument{
+		SchemaVersion: JSONSchemaVersion,
+		Product:       product,
+		QueriedAt:     now.UTC(),
+		Cycles:        jsonCycles(cycles, opts, now),
+	}
+	doc.SnapshotDate = snapshotDate(opts)
+
+	return encodeJSON(w, doc)
+}
+
+// writeJSONProducts writes the cycles of several products as one JSON document
+func writeJSONProducts(w io.Writer, products []ProductCycles, opts Options, now time.Time) error {
+	doc := jsonMultiDocument{
+		SchemaVersion: JSONSchemaVersion,
+		QueriedAt:     now.UTC(),
+		SnapshotDate:  snapshotDate(opts),
+		Products:      make([]jsonProduct, 0, len(products)),
+	}
+	for _, p := range products {
+		doc.Products = append(doc.Products, jsonProduct{Product: p.Product, Cycles: jsonCycles(p.Cycles, opts, now)})
+	}
+
+	return encodeJSON(w, doc)
+}
+
+// snapshotDate returns the snapshot creation time for the document, if any
+func snapshotDate(opts Options) *time.Time {
+	if opts.SnapshotDate.IsZero() {
+		return nil
+	}
+	snapshot := opts.SnapshotDate.UTC()
+	return &snapshot
+}
+
+// encodeJSON writes v as indented JSON
+func encodeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// formatAsNDJSON writes one compact JSON object per cycle
+func formatAsNDJSON(w io.Writer, product string, cycles []api.Cycle, opts Options, now time.Time) error {
+	enc := json.NewEncoder(w)
+	for _, c := range jsonCycles(cycles, opts, now) {
+		line := ndjsonLine{
+			SchemaVersion: JSONSchemaVersion,
+			Product:       product,
+			QueriedAt:     now.UTC(),
+			jsonCycle:     c,
+		}
+		if err := enc.Encode(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
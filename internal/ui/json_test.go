@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// updateGolden reports whether golden files are rewritten (-update). The
+// flag is registered by the golden package teatest builds on.
+func updateGolden() bool {
+	f := flag.Lookup("update")
+	return f != nil && f.Value.String() == "true"
+}
+
+// goldenCycles covers date, boolean and unset values
+func goldenCycles() []api.Cycle {
+	date := func(s string) time.Time {
+		t, _ := time.Parse("2006-01-02", s)
+		return t
+	}
+	return []api.Cycle{
+		{
+			Cycle:       "3.14",
+			Latest:      "3.14.2",
+			ReleaseDate: api.Date{Time: date("2025-10-07")},
+			Support:     api.EOLValue{DateValue: date("2027-10-01")},
+			EOL:         api.EOLValue{DateValue: date("2030-10-31")},
+		},
+		{
+			Cycle:       "3.12",
+			Latest:      "3.12.12",
+			ReleaseDate: api.Date{Time: date("2023-10-02")},
+			Support:     api.EOLValue{DateValue: date("2025-04-02")},
+			EOL:         api.EOLValue{DateValue: date("2028-10-31")},
+			LTS:         api.LTSValue{IsBoolean: true, BoolValue: true},
+			Link:        "https://docs.python.org/3.12/whatsnew/",
+		},
+		{
+			Cycle:   "2.7",
+			Latest:  "2.7.18",
+			Support: api.EOLValue{IsBoolean: true, BoolValue: false},
+			EOL:     api.EOLValue{IsBoolean: true, BoolValue: true},
+		},
+	}
+}
+
+// assertGolden compares got with testdata/name, rewriting it with -update
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if updateGolden() {
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s:\n%s", path, got)
+	}
+}
+
+func TestFormatAsJSON(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "cycles.json", opts: Options{}},
+		{name: "cycles_all.json", opts: Options{ShowAll: true}},
+		{name: "cycles_snapshot.json", opts: Options{SnapshotDate: time.Date(2026, 1, 1, 8, 30, 0, 0, time.UTC)}},
+		{name: "cycles_expiring.json", opts: Options{WarnDays: 700}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteJSON(&buf, "python", goldenCycles(), tt.opts, now); err != nil {
+				t.Fatalf("WriteJSON() error = %v", err)
+			}
+			assertGolden(t, tt.name, buf.Bytes())
+		})
+	}
+}
+
+func TestFormatAsJSON_NoCycles(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, "python", nil, Options{}, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"cycles": []`)) {
+		t.Errorf("WriteJSON() without cycles = %s, want empty cycles array", buf.Bytes())
+	}
+}
+
+func TestFormatAsNDJSON(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := formatAsNDJSON(&buf, "python", goldenCycles(), Options{ShowAll: true}, now); err != nil {
+		t.Fatalf("formatAsNDJSON() error = %v", err)
+	}
+	assertGolden(t, "cycles.ndjson", buf.Bytes())
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// OpenMetricsContentType is the HTTP content type of WriteOpenMetrics output
+const OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// cycleGauge is a per-cycle gauge of the OpenMetrics output
+type cycleGauge struct {
+	// value returns the sample value and whether the cycle has one
+	value func(c api.Cycle, now time.Time) (int, bool)
+	name  string
+	help  string
+}
+
+// cycleGauges lists the per-cycle gauges in output order
+var cycleGauges = []cycleGauge{
+	{
+		name: "eol_date_days_until_eol",
+		help: "Days until the end of life of the release cycle, negative once passed.",
+		value: func(c api.Cycle, now time.Time) (int, bool) {
+			if c.EOL.IsBoolean || c.EOL.DateValue.IsZero() {
+				return 0, false
+			}
+			return api.DaysUntil(now, c.EOL.DateValue), true
+		},
+	},
+	{
+		name: "eol_date_days_until_support_end",
+		help: "Days until active support of the release cycle ends, negative once passed.",
+		value: func(c api.Cycle, now time.Time) (int, bool) {
+			if c.Support.IsBoolean || c.Support.DateValue.IsZero() {
+				return 0, false
+			}
+			return api.DaysUntil(now, c.Support.DateValue), true
+		},
+	},
+	{
+		name: "eol_date_is_eol",
+		help: "Whether the release cycle reached its end of life (1) or not (0).",
+		value: func(c api.Cycle, now time.Time) (int, bool) {
+			return boolGauge(c.EOL.IsEOLAt(now)), true
+		},
+	},
+	{
+		name: "eol_date_is_lts",
+		help: "Whether the release cycle is a long-term support release (1) or not (0).",
+		value: func(c api.Cycle, _ time.Time) (int, bool) {
+			return boolGauge(c.LTS.IsLTS()), true
+		},
+	},
+}
+
+// WriteOpenMetrics writes gauges for all cycles of products in the OpenMetrics
+// text format, which the Prometheus textfile collector also understands.
+// refreshed is the time the data was fetched.
+func WriteOpenMetrics(w io.Writer, products []ProductCycles, refreshed, now time.Time) error {
+	var b strings.Builder
+
+	for _, g := range cycleGauges {
+		fmt.Fprintf(&b, "# TYPE %s gauge\n", g.name)
+		fmt.Fprintf(&b, "# HELP %s %s\n", g.name, g.help)
+		for _, p := range products {
+			for _, c := range p.Cycles {
+				if v, ok := g.value(c, now); ok {
+					fmt.Fprintf(&b, "%s{product=\"%s\",cycle=\"%s\"} %d\n",
+						g.name, escapeLabelValue(p.Product), escapeLabelValue(c.Cycle), v)
+				}
+			}
+		}
+	}
+
+	b.WriteString("# TYPE eol_date_last_refresh_timestamp gauge\n")
+	b.WriteString("# HELP eol_date_last_refresh_timestamp Unix time of the last successful data refresh.\n")
+	fmt.Fprintf(&b, "eol_date_last_refresh_timestamp %d\n", refreshed.Unix())
+	b.WriteString("# EOF\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// boolGauge converts b to a 0/1 sample value
+func boolGauge(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// escapeLabelValue escapes a label value for the text exposition format
+func escapeLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestWriteOpenMetrics(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	products := []ProductCycles{{Product: "python", Cycles: goldenCycles()}}
+
+	var buf bytes.Buffer
+	if err := WriteOpenMetrics(&buf, products, now.Add(-time.Hour), now); err != nil {
+		t.Fatalf("WriteOpenMetrics() error = %v", err)
+	}
+	assertGolden(t, "cycles.openmetrics", buf.Bytes())
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	got := escapeLabelValue("a\"b\\c\nd")
+	want := `a\"b\\c\nd`
+	if got != want {
+		t.Errorf("escapeLabelValue() = %q, want %q", got, want)
+	}
+}
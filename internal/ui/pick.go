@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/oliverandrich/eol-date/internal/search"
+)
+
+// ExitAmbiguous is the exit code when a query matches several products and
+// none can be picked without asking
+const ExitAmbiguous = 7
+
+// Pick is how a query without an exact match is resolved
+type Pick int
+
+const (
+	// PickPrompt lets the user choose with SelectProducts
+	PickPrompt Pick = iota
+	// PickTop takes the best-ranked match
+	PickTop
+	// PickFail lists the candidates and fails with ExitAmbiguous
+	PickFail
+)
+
+// DecidePick returns how to resolve a query from its ranked matches. With
+// yes the best match is taken. Without a terminal a single candidate is
+// taken as well, while several candidates fail instead of prompting. The
+// picker lists all products, so it is shown even if nothing matches.
+func DecidePick(matches []search.Match, interactive, yes bool) Pick {
+	switch {
+	case yes && len(matches) > 0:
+		return PickTop
+	case interactive && !yes:
+		return PickPrompt
+	case len(matches) == 1:
+		return PickTop
+	}
+	return PickFail
+}
+
+// IsInteractive reports whether stdin and stdout are terminals, so the
+// product picker can be shown
+func IsInteractive() bool {
+	return isTerminal(os.Stdin) && isTerminal(os.Stdout)
+}
+
+func isTerminal(f *os.File) bool {
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
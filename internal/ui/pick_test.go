@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"testing"
+
+	"github.com/oliverandrich/eol-date/internal/search"
+)
+
+func TestDecidePick(t *testing.T) {
+	one := []search.Match{{Product: "nodejs", Score: 400}}
+	several := []search.Match{{Product: "postgres", Score: 896}, {Product: "postgresql", Score: 894}}
+
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name        string
+		matches     []search.Match
+		interactive bool
+		yes         bool
+		want        Pick
+	}{
+		{name: "terminal prompts", matches: several, interactive: true, want: PickPrompt},
+		{name: "terminal prompts for a single match", matches: one, interactive: true, want: PickPrompt},
+		{name: "yes takes the best match", matches: several, interactive: true, yes: true, want: PickTop},
+		{name: "yes without terminal", matches: several, yes: true, want: PickTop},
+		{name: "no terminal, single match", matches: one, want: PickTop},
+		{name: "no terminal, several matches", matches: several, want: PickFail},
+		{name: "terminal prompts without matches", interactive: true, want: PickPrompt},
+		{name: "yes without matches", interactive: true, yes: true, want: PickFail},
+		{name: "no terminal, no matches", want: PickFail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DecidePick(tt.matches, tt.interactive, tt.yes); got != tt.want {
+				t.Errorf("DecidePick() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// ProductCycles holds the release cycles of one product
+type ProductCycles struct {
+	Product string
+	Cycles  []api.Cycle
+}
+
+// DisplayProducts prints the release cycles of several products. The table,
+// markdown and html formats show one section per product unless
+// opts.Combined is set; csv always uses a single table with a PRODUCT column
+// and the structured formats produce one document covering all products.
+func DisplayProducts(products []ProductCycles, opts Options) error {
+	if err := ValidateFormat(opts.Format); err != nil {
+		return err
+	}
+	if len(products) == 1 && !opts.Combined {
+		return DisplayCycles(products[0].Product, products[0].Cycles, opts)
+	}
+
+	now := opts.now()
+	switch opts.Format {
+	case "json":
+		return writeJSONProducts(os.Stdout, products, opts, now)
+	case "ndjson":
+		for _, p := range products {
+			if err := formatAsNDJSON(os.Stdout, p.Product, p.Cycles, opts, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "yaml":
+		return encodeYAML(os.Stdout, newExportProducts(products, opts, now))
+	case "toml":
+		return encodeTOML(os.Stdout, newExportProducts(products, opts, now))
+	case "ics":
+		return WriteCalendar(os.Stdout, products, opts, time.Now(), now)
+	case "openmetrics":
+		// The data was fetched just now, even when evaluated at --as-of
+		return WriteOpenMetrics(os.Stdout, products, time.Now(), now)
+	case "csv":
+		formatAsCSV(combinedRows(products, opts), opts.Columns)
+		return nil
+	}
+
+	if !opts.Combined {
+		for i, p := range products {
+			if i > 0 && opts.Format == "markdown" {
+				fmt.Println()
+			}
+			if err := DisplayCycles(p.Product, p.Cycles, opts); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	names := make([]string, len(products))
+	var cycles []api.Cycle
+	for i, p := range products {
+		names[i] = p.Product
+		cycles = append(cycles, p.Cycles...)
+	}
+	title := strings.Join(names, ", ")
+
+	rows := combinedRows(products, opts)
+	if len(rows) == 0 {
+		printNoCycles(title, opts.ShowAll)
+		return nil
+	}
+
+	switch opts.Format {
+	case "markdown":
+		formatAsMarkdown(title, rows, opts.Columns)
+	case "html":
+		formatAsHTML(os.Stdout, title, rows, opts.Columns)
+	default:
+		formatAsTable(title, cycles, rows, opts)
+	}
+	return nil
+}
+
+// combinedRows returns the display rows of all products with the product set
+func combinedRows(products []ProductCycles, opts Options) []displayRow {
+	var rows []displayRow
+	for _, p := range products {
+		for _, r := range prepareDisplayRows(p.Cycles, opts.ShowAll, opts.WarnDays, opts.now()) {
+			r.Product = p.Product
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
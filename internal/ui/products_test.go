@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// goldenProducts returns two products for the multi-product tests
+func goldenProducts() []ProductCycles {
+	return []ProductCycles{
+		{Product: "python", Cycles: goldenCycles()},
+		{Product: "go", Cycles: []api.Cycle{{
+			Cycle:       "1.25",
+			Latest:      "1.25.4",
+			ReleaseDate: api.Date{Time: time.Date(2025, 8, 12, 0, 0, 0, 0, time.UTC)},
+			EOL:         api.EOLValue{IsBoolean: true, BoolValue: false},
+		}}},
+	}
+}
+
+func TestWriteJSONProducts(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := writeJSONProducts(&buf, goldenProducts(), Options{}, now); err != nil {
+		t.Fatalf("writeJSONProducts() error = %v", err)
+	}
+	assertGolden(t, "products.json", buf.Bytes())
+}
+
+func TestDisplayProducts_CSV(t *testing.T) {
+	output := captureStdout(func() {
+		if err := DisplayProducts(goldenProducts(), Options{Format: "csv"}); err != nil {
+			t.Errorf("DisplayProducts() error = %v", err)
+		}
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if !strings.HasPrefix(lines[0], "PRODUCT,CYCLE,") {
+		t.Errorf("CSV header = %q, want PRODUCT column first", lines[0])
+	}
+	for _, prefix := range []string{"python,3.14,", "python,3.12,", "go,1.25,"} {
+		if !strings.Contains(output, "\n"+prefix) {
+			t.Errorf("CSV output missing row starting with %q:\n%s", prefix, output)
+		}
+	}
+}
+
+func TestDisplayProducts_Markdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		combined bool
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:    "sections",
+			want:    []string{"# Release cycles for python", "# Release cycles for go", "| CYCLE |"},
+			notWant: []string{"| PRODUCT |"},
+		},
+		{
+			name:     "combined",
+			combined: true,
+			want:     []string{"# Release cycles for python, go", "| PRODUCT | CYCLE |", "| go | 1.25 |"},
+			notWant:  []string{"# Release cycles for python\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureStdout(func() {
+				if err := DisplayProducts(goldenProducts(), Options{Format: "markdown", Combined: tt.combined}); err != nil {
+					t.Errorf("DisplayProducts() error = %v", err)
+				}
+			})
+			for _, s := range tt.want {
+				if !strings.Contains(output, s) {
+					t.Errorf("output missing %q:\n%s", s, output)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(output, s) {
+					t.Errorf("output unexpectedly contains %q:\n%s", s, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayProducts_Empty(t *testing.T) {
+	ended := []api.Cycle{{Cycle: "2.7", EOL: api.EOLValue{IsBoolean: true, BoolValue: true}}}
+
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name    string
+		cycles  []api.Cycle
+		showAll bool
+		want    string
+		notWant string
+	}{
+		{"only ended cycles", ended, false, "No active release cycles found for python, go\nUse --all to show end-of-life versions", ""},
+		{"no cycles at all", nil, true, "No release cycles found for python, go", "--all"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := []ProductCycles{{Product: "python", Cycles: tt.cycles}, {Product: "go", Cycles: tt.cycles}}
+			output := captureStdout(func() {
+				if err := DisplayProducts(products, Options{Format: "table", Combined: true, ShowAll: tt.showAll}); err != nil {
+					t.Errorf("DisplayProducts() error = %v", err)
+				}
+			})
+			if !strings.Contains(output, tt.want) {
+				t.Errorf("output = %q, want %q", output, tt.want)
+			}
+			if tt.notWant != "" && strings.Contains(output, tt.notWant) {
+				t.Errorf("output = %q, unexpectedly contains %q", output, tt.notWant)
+			}
+		})
+	}
+}
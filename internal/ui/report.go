@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// Level classifies a report entry for coloring and the summary line
+type Level int
+
+const (
+	// LevelOK means the version is supported
+	LevelOK Level = iota
+	// LevelWarn means the version is supported but needs attention
+	LevelWarn
+	// LevelEOL means the version reached its end of life
+	LevelEOL
+	// LevelUnknown means the product or release cycle could not be resolved
+	LevelUnknown
+)
+
+// ReportEntry is one detected version and its support status
+type ReportEntry struct {
+	// Product is the endoflife.date product name
+	Product string
+	// Version is the detected version
+	Version string
+	// Source is where the version was detected, e.g. "go.mod:3"
+	Source string
+	// Cycle is the matched release cycle, empty if none matched
+	Cycle api.Cycle
+	// Status is a short, human-readable verdict
+	Status string
+	Level  Level
+}
+
+// reportHeaders are the column titles shared by all report formats
+var reportHeaders = []string{"PRODUCT", "VERSION", "CYCLE", "LATEST", "EOL", "STATUS", "SOURCE"}
+
+// reportCells returns the cells of an entry, rendering the EOL date relative
+// to now with formatDate
+func reportCells(e ReportEntry, now time.Time, formatDate func(rel, raw string) string) []string {
+	eol := ""
+	if e.Cycle.Cycle != "" {
+		eol = formatDate(formatEOL(e.Cycle.EOL, now).relative, formatRawValue(e.Cycle.EOL))
+	}
+	return []string{e.Product, e.Version, e.Cycle.Cycle, e.Cycle.Latest, eol, e.Status, e.Source}
+}
+
+// levelColor returns the table color of a level
+func levelColor(l Level) lipgloss.TerminalColor {
+	switch l {
+	case LevelOK:
+		return theme.Active
+	case LevelWarn:
+		return theme.Warn
+	case LevelEOL:
+		return theme.EOL
+	case LevelUnknown:
+		return theme.Dim
+	}
+	return theme.Active
+}
+
+// ReportFormats lists the output formats supported by DisplayReport
+var ReportFormats = []string{"table", "markdown", "csv", "html"}
+
+// DisplayReport prints the combined report of a project scan in the format given by opts
+func DisplayReport(title string, entries []ReportEntry, opts Options) error {
+	if !slices.Contains(ReportFormats, opts.Format) {
+		return fmt.Errorf("format '%s' is not supported for reports (valid formats: %s)",
+			opts.Format, strings.Join(ReportFormats, ", "))
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No runtimes or dependencies detected in", title)
+		return nil
+	}
+
+	switch opts.Format {
+	case "markdown":
+		reportAsMarkdown(title, entries, opts.now())
+	case "csv":
+		reportAsCSV(entries, opts.now())
+	case "html":
+		reportAsHTML(title, entries, opts.now())
+	default:
+		reportAsTable(title, entries, opts)
+	}
+	return nil
+}
+
+// reportAsTable renders the report as a lipgloss table
+func reportAsTable(title string, entries []ReportEntry, opts Options) {
+	fmt.Println()
+	fmt.Println(headerStyle.Render(fmt.Sprintf("EOL report for %s", title)))
+	fmt.Println()
+
+	now := opts.now()
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		rows[i] = reportCells(e, now, func(rel, raw string) string {
+			if date := dateOnly(raw); date != "" && rel != "" {
+				return rel + " " + date
+			}
+			return rel
+		})
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(theme.Dim)).
+		Headers(reportHeaders...).
+		Rows(rows...).
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return tableHeaderStyle.Padding(0, 1)
+			}
+			return lipgloss.NewStyle().Padding(0, 1).Foreground(levelColor(entries[row].Level))
+		})
+
+	fmt.Println(t.Render())
+	fmt.Println()
+
+	counts := make(map[Level]int)
+	for _, e := range entries {
+		counts[e.Level]++
+	}
+	summary := fmt.Sprintf("%d supported, %d need attention, %d EOL", counts[LevelOK], counts[LevelWarn], counts[LevelEOL])
+	if counts[LevelUnknown] > 0 {
+		summary += fmt.Sprintf(", %d unknown", counts[LevelUnknown])
+	}
+	fmt.Println(dimStyle.Render(summary))
+
+	if !opts.SnapshotDate.IsZero() {
+		fmt.Println(dimStyle.Render(fmt.Sprintf("Offline data from snapshot created %s (%s ago)",
+			opts.SnapshotDate.Format("2006-01-02"), formatDuration(time.Since(opts.SnapshotDate)))))
+	}
+}
+
+// reportAsMarkdown renders the report as a Markdown table
+func reportAsMarkdown(title string, entries []ReportEntry, now time.Time) {
+	fmt.Printf("# EOL report for %s\n\n", title)
+
+	separators := make([]string, len(reportHeaders))
+	for i, h := range reportHeaders {
+		separators[i] = strings.Repeat("-", len(h)+2)
+	}
+	fmt.Printf("| %s |\n", strings.Join(reportHeaders, " | "))
+	fmt.Printf("|%s|\n", strings.Join(separators, "|"))
+
+	for _, e := range entries {
+		fmt.Printf("| %s |\n", strings.Join(reportCells(e, now, formatMarkdownDate), " | "))
+	}
+}
+
+// reportAsCSV renders the report as CSV with raw dates
+func reportAsCSV(entries []ReportEntry, now time.Time) {
+	w := csv.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	_ = w.Write(reportHeaders)
+
+	for _, e := range entries {
+		_ = w.Write(reportCells(e, now, func(_, raw string) string { return raw }))
+	}
+}
+
+// reportAsHTML renders the report as an HTML table
+func reportAsHTML(title string, entries []ReportEntry, now time.Time) {
+	fmt.Printf("<h1>EOL report for %s</h1>\n", html.EscapeString(title))
+	fmt.Println("<table>")
+	fmt.Println("  <thead>")
+	fmt.Printf("    <tr><th>%s</th></tr>\n", strings.Join(reportHeaders, "</th><th>"))
+	fmt.Println("  </thead>")
+	fmt.Println("  <tbody>")
+
+	colors := map[Level]string{LevelOK: "green", LevelWarn: "orange", LevelEOL: "red", LevelUnknown: "gray"}
+	for _, e := range entries {
+		cells := reportCells(e, now, formatHTMLDate)
+		for i, c := range cells {
+			cells[i] = html.EscapeString(c)
+		}
+		fmt.Printf("    <tr style=\"color: %s;\"><td>%s</td></tr>\n", colors[e.Level], strings.Join(cells, "</td><td>"))
+	}
+
+	fmt.Println("  </tbody>")
+	fmt.Println("</table>")
+}
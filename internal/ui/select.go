@@ -4,128 +4,355 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
-	"io"
 	"strings"
 
-	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/oliverandrich/eol-date/internal/api"
+	"github.com/oliverandrich/eol-date/internal/search"
+)
+
+// ErrSelectionCancelled is returned by SelectProducts if the user quits the picker
+var ErrSelectionCancelled = errors.New("selection cancelled")
+
+const (
+	// pickerRows is the number of products shown at once
+	pickerRows = 12
+	// pickerListWidth is the width of the product list, the preview gets the rest
+	pickerListWidth = 34
+	// pickerMinPreviewWidth keeps the preview readable in narrow terminals
+	pickerMinPreviewWidth = 36
 )
 
 var (
-	titleStyle        = lipgloss.NewStyle().MarginLeft(2)
-	itemStyle         = lipgloss.NewStyle().PaddingLeft(4)
-	selectedItemStyle = lipgloss.NewStyle().PaddingLeft(2).Foreground(lipgloss.Color("170"))
-	paginationStyle   = list.DefaultStyles().PaginationStyle.PaddingLeft(4)
-	helpStyle         = list.DefaultStyles().HelpStyle.PaddingLeft(4).PaddingBottom(1)
+	pickerItemStyle     = lipgloss.NewStyle().PaddingLeft(2)
+	pickerCursorStyle   = lipgloss.NewStyle().Foreground(theme.Selected)
+	pickerHelpStyle     = lipgloss.NewStyle().Foreground(theme.Dim).PaddingLeft(2)
+	pickerPreviewBorder = lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(theme.Dim).
+				Padding(0, 1)
 )
 
-type item string
+// FetchFunc fetches the release cycles of a product for the picker preview
+// and the browser
+type FetchFunc func(product string) ([]api.Cycle, error)
 
-func (i item) FilterValue() string { return string(i) }
+// preview is the state of the preview of one product
+type preview struct {
+	err     error
+	cycles  []api.Cycle
+	loading bool
+}
 
-type itemDelegate struct{}
+// previewMsg delivers the result of a preview fetch
+type previewMsg struct {
+	err     error
+	product string
+	cycles  []api.Cycle
+}
 
-func (d itemDelegate) Height() int                             { return 1 }
-func (d itemDelegate) Spacing() int                            { return 0 }
-func (d itemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
-func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
-	i, ok := listItem.(item)
-	if !ok {
-		return
+// picker is the Bubble Tea model of the product picker
+type picker struct {
+	fetch    FetchFunc
+	previews map[string]*preview
+	marked   map[string]bool
+	opts     Options
+	input    textinput.Model
+	products []string
+	matches  []string
+	// order lists the marked products in the order they were marked
+	order     []string
+	cursor    int
+	offset    int
+	width     int
+	done      bool
+	cancelled bool
+}
+
+// newPicker creates the picker over products, filtered by query
+func newPicker(products []string, query string, fetch FetchFunc, opts Options) picker {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "type to filter"
+	input.SetValue(query)
+	input.Focus()
+
+	p := picker{
+		fetch:    fetch,
+		previews: make(map[string]*preview),
+		marked:   make(map[string]bool),
+		opts:     opts,
+		input:    input,
+		products: products,
+		width:    pickerListWidth + pickerMinPreviewWidth + 4,
 	}
+	p.filter()
+	return p
+}
 
-	str := string(i)
+// filter ranks all products by the current input
+func (p *picker) filter() {
+	p.matches = search.Names(search.FindSimilar(p.products, p.input.Value(), len(p.products)))
+	p.cursor = 0
+	p.offset = 0
+}
 
-	fn := itemStyle.Render
-	if index == m.Index() {
-		fn = func(s ...string) string {
-			return selectedItemStyle.Render("> " + strings.Join(s, " "))
-		}
+// current returns the product under the cursor
+func (p *picker) current() (string, bool) {
+	if p.cursor < len(p.matches) {
+		return p.matches[p.cursor], true
 	}
+	return "", false
+}
 
-	_, _ = fmt.Fprint(w, fn(str))
+// move moves the cursor by delta and scrolls the list to keep it visible
+func (p *picker) move(delta int) {
+	if len(p.matches) == 0 {
+		return
+	}
+	p.cursor = max(0, min(len(p.matches)-1, p.cursor+delta))
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+pickerRows {
+		p.offset = p.cursor - pickerRows + 1
+	}
 }
 
-type model struct {
-	list     list.Model
-	choice   string
-	quitting bool
+// toggle marks or unmarks the product under the cursor
+func (p *picker) toggle() {
+	product, ok := p.current()
+	if !ok {
+		return
+	}
+	if p.marked[product] {
+		delete(p.marked, product)
+		p.order = removeString(p.order, product)
+		return
+	}
+	p.marked[product] = true
+	p.order = append(p.order, product)
 }
 
-func (m model) Init() tea.Cmd {
+// selection returns the chosen products: the marked ones, or else the one
+// under the cursor
+func (p *picker) selection() []string {
+	if len(p.order) > 0 {
+		return p.order
+	}
+	if product, ok := p.current(); ok {
+		return []string{product}
+	}
 	return nil
 }
 
-func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+// loadPreview returns a command fetching the preview of the product under
+// the cursor, unless it is loaded or loading already
+func (p *picker) loadPreview() tea.Cmd {
+	product, ok := p.current()
+	if !ok || p.fetch == nil {
+		return nil
+	}
+	if _, ok := p.previews[product]; ok {
+		return nil
+	}
+
+	p.previews[product] = &preview{loading: true}
+	fetch := p.fetch
+	return func() tea.Msg {
+		cycles, err := fetch(product)
+		return previewMsg{product: product, cycles: cycles, err: err}
+	}
+}
+
+func (p picker) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, p.loadPreview())
+}
+
+func (p picker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.list.SetWidth(msg.Width)
-		return m, nil
+		p.width = msg.Width
+		return p, nil
+
+	case previewMsg:
+		p.previews[msg.product] = &preview{cycles: msg.cycles, err: msg.err}
+		return p, nil
 
 	case tea.KeyMsg:
-		switch keypress := msg.String(); keypress {
-		case "ctrl+c", "q":
-			m.quitting = true
-			return m, tea.Quit
-
-		case "enter":
-			i, ok := m.list.SelectedItem().(item)
-			if ok {
-				m.choice = string(i)
+		switch msg.Type {
+		case tea.KeyCtrlC, tea.KeyEsc:
+			p.cancelled = true
+			return p, tea.Quit
+		case tea.KeyEnter:
+			if len(p.selection()) > 0 {
+				p.done = true
+				return p, tea.Quit
+			}
+			return p, nil
+		case tea.KeyUp, tea.KeyCtrlP:
+			p.input.Blur()
+			p.move(-1)
+			return p, p.loadPreview()
+		case tea.KeyDown, tea.KeyCtrlN:
+			p.input.Blur()
+			p.move(1)
+			return p, p.loadPreview()
+		case tea.KeyPgUp:
+			p.input.Blur()
+			p.move(-pickerRows)
+			return p, p.loadPreview()
+		case tea.KeyPgDown:
+			p.input.Blur()
+			p.move(pickerRows)
+			return p, p.loadPreview()
+		case tea.KeySpace:
+			// While typing, space belongs to the filter, e.g. "amazon linux"
+			if !p.input.Focused() {
+				p.toggle()
+				return p, nil
 			}
-			return m, tea.Quit
+		case tea.KeyTab, tea.KeyCtrlAt:
+			p.toggle()
+			return p, nil
 		}
 	}
 
+	// Moving through the list leaves the filter; any other key returns to it
+	var focus tea.Cmd
+	if _, ok := msg.(tea.KeyMsg); ok && !p.input.Focused() {
+		focus = p.input.Focus()
+	}
+
+	before := p.input.Value()
 	var cmd tea.Cmd
-	m.list, cmd = m.list.Update(msg)
-	return m, cmd
+	p.input, cmd = p.input.Update(msg)
+	if p.input.Value() != before {
+		p.filter()
+		return p, tea.Batch(focus, cmd, p.loadPreview())
+	}
+	return p, tea.Batch(focus, cmd)
 }
 
-func (m model) View() string {
-	if m.choice != "" {
+func (p picker) View() string {
+	if p.done || p.cancelled {
 		return ""
 	}
-	if m.quitting {
-		return ""
+
+	list := []string{p.input.View(), ""}
+	end := min(len(p.matches), p.offset+pickerRows)
+	for i := p.offset; i < end; i++ {
+		product := p.matches[i]
+		mark := "[ ]"
+		if p.marked[product] {
+			mark = "[x]"
+		}
+		line := truncate(fmt.Sprintf("%s %s", mark, product), pickerListWidth-4)
+		if i == p.cursor {
+			list = append(list, pickerCursorStyle.Render("> "+line))
+		} else {
+			list = append(list, pickerItemStyle.Render(line))
+		}
+	}
+	if len(p.matches) == 0 {
+		list = append(list, pickerItemStyle.Render(dimStyle.Render("no matching products")))
+	}
+	for len(list) < pickerRows+2 {
+		list = append(list, "")
+	}
+
+	left := lipgloss.NewStyle().Width(pickerListWidth).Render(strings.Join(list, "\n"))
+	right := pickerPreviewBorder.
+		Width(max(pickerMinPreviewWidth, p.width-pickerListWidth-4)).
+		Height(pickerRows + 1).
+		Render(p.previewView())
+
+	help := fmt.Sprintf("↑/↓ move · space/tab mark · enter show · esc quit · %d of %d products", len(p.matches), len(p.products))
+	if len(p.order) > 0 {
+		help += fmt.Sprintf(" · %d marked", len(p.order))
 	}
-	return "\n" + m.list.View()
+
+	return "\n" + lipgloss.JoinHorizontal(lipgloss.Top, left, right) + "\n" + pickerHelpStyle.Render(help) + "\n"
 }
 
-// SelectProduct displays an interactive list for product selection
-func SelectProduct(matches []string) (string, error) {
-	items := make([]list.Item, len(matches))
-	for i, m := range matches {
-		items[i] = item(m)
+// previewView renders the active release cycles of the product under the cursor
+func (p picker) previewView() string {
+	product, ok := p.current()
+	if !ok {
+		return ""
 	}
 
-	const listHeight = 14
+	lines := []string{headerStyle.Render(product), ""}
+	state, ok := p.previews[product]
+	switch {
+	case !ok || state.loading:
+		lines = append(lines, dimStyle.Render("Loading…"))
+	case state.err != nil:
+		lines = append(lines, lipgloss.NewStyle().Foreground(theme.EOL).Render(state.err.Error()))
+	default:
+		rows := prepareDisplayRows(state.cycles, false, p.opts.WarnDays, p.opts.now())
+		if len(rows) == 0 {
+			lines = append(lines, dimStyle.Render("No active release cycles"))
+			break
+		}
+		lines = append(lines, tableHeaderStyle.Render(fmt.Sprintf("%-10s %-12s %s", "CYCLE", "LATEST", "EOL")))
+		for i, r := range rows {
+			if i == pickerRows-3 {
+				lines = append(lines, dimStyle.Render(fmt.Sprintf("… %d more", len(rows)-i)))
+				break
+			}
+			eol := r.EOLRel
+			if date := dateOnly(r.EOLRaw); date != "" {
+				eol += " " + date
+			}
+			line := fmt.Sprintf("%-10s %-12s %s", truncate(r.Cycle, 10), truncate(r.Latest, 12), eol)
+			lines = append(lines, lipgloss.NewStyle().Foreground(statusColor(r.Status)).Render(line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
 
-	l := list.New(items, itemDelegate{}, 40, listHeight)
-	l.Title = "Select a product:"
-	l.SetShowStatusBar(false)
-	l.SetFilteringEnabled(false)
-	l.Styles.Title = titleStyle
-	l.Styles.PaginationStyle = paginationStyle
-	l.Styles.HelpStyle = helpStyle
+// truncate shortens s to at most n runes, marking the cut with an ellipsis
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
 
-	m := model{list: l}
+// removeString returns s without the first occurrence of v
+func removeString(s []string, v string) []string {
+	for i, x := range s {
+		if x == v {
+			return append(s[:i:i], s[i+1:]...)
+		}
+	}
+	return s
+}
 
-	p := tea.NewProgram(m)
-	finalModel, err := p.Run()
+// SelectProducts shows an interactive picker over all products, filtered
+// live by what the user types and starting with query. A preview pane shows
+// the active release cycles of the product under the cursor, fetched lazily
+// through fetch. Space marks several products once the cursor was moved,
+// tab also while typing; enter returns the marked products, or the one
+// under the cursor if none is marked.
+func SelectProducts(products []string, query string, fetch FetchFunc, opts Options) ([]string, error) {
+	final, err := tea.NewProgram(newPicker(products, query, fetch, opts)).Run()
 	if err != nil {
-		return "", fmt.Errorf("error running selection: %w", err)
+		return nil, fmt.Errorf("error running selection: %w", err)
 	}
 
-	fm, ok := finalModel.(model)
+	p, ok := final.(picker)
 	if !ok {
-		return "", fmt.Errorf("unexpected model type")
+		return nil, fmt.Errorf("unexpected model type")
 	}
-	if fm.quitting && fm.choice == "" {
-		return "", fmt.Errorf("selection cancelled")
+	if !p.done {
+		return nil, ErrSelectionCancelled
 	}
-
-	return fm.choice, nil
+	return p.selection(), nil
 }
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+var pickerProducts = []string{"go", "nodejs", "postgresql", "python", "ruby"}
+
+// press sends keys to the picker and returns the updated model and the
+// last command
+func press(p picker, keys ...tea.KeyMsg) (picker, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, key := range keys {
+		var m tea.Model
+		m, cmd = p.Update(key)
+		p = m.(picker)
+	}
+	return p, cmd
+}
+
+func typed(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestPicker_Filter(t *testing.T) {
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name  string
+		query string
+		want  []string
+	}{
+		{"empty query lists all products", "", pickerProducts},
+		{"prefix", "py", []string{"python"}},
+		{"typo", "pyhton", []string{"python"}},
+		{"no match", "cobol", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPicker(pickerProducts, tt.query, nil, Options{})
+			if !slices.Equal(p.matches, tt.want) {
+				t.Errorf("matches = %v, want %v", p.matches, tt.want)
+			}
+		})
+	}
+}
+
+func TestPicker_TypingFilters(t *testing.T) {
+	p := newPicker(pickerProducts, "", nil, Options{})
+	p, _ = press(p, tea.KeyMsg{Type: tea.KeyDown}, typed("n"), typed("o"))
+
+	if len(p.matches) == 0 || p.matches[0] != "nodejs" {
+		t.Errorf("matches = %v, want nodejs first", p.matches)
+	}
+	if p.cursor != 0 {
+		t.Errorf("cursor = %d, want reset to 0", p.cursor)
+	}
+}
+
+func TestPicker_Enter(t *testing.T) {
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+	ctrlSpace := tea.KeyMsg{Type: tea.KeyCtrlAt}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		name string
+		keys []tea.KeyMsg
+		want []string
+	}{
+		{"current product", []tea.KeyMsg{down, enter}, []string{"nodejs"}},
+		{"unmarking removes the product", []tea.KeyMsg{down, down, space, down, space, tea.KeyMsg{Type: tea.KeyUp}, space, enter}, []string{"python"}},
+		{"marked products keep marking order", []tea.KeyMsg{down, down, tab, tea.KeyMsg{Type: tea.KeyUp}, ctrlSpace, enter}, []string{"postgresql", "nodejs"}},
+		{"marks survive filtering", []tea.KeyMsg{tab, typed("ruby"), tab, enter}, []string{"go", "ruby"}},
+		{"typing after moving filters again", []tea.KeyMsg{down, space, typed("ruby"), space, enter}, []string{"nodejs"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, cmd := press(newPicker(pickerProducts, "", nil, Options{}), tt.keys...)
+			if !p.done || cmd == nil {
+				t.Fatal("enter did not finish the picker")
+			}
+			if got := p.selection(); !slices.Equal(got, tt.want) {
+				t.Errorf("selection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPicker_SpaceFilters(t *testing.T) {
+	products := []string{"amazon-linux", "amazon-neptune", "go"}
+	p, _ := press(newPicker(products, "", nil, Options{}), typed("amazon"), tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}, typed("linux"))
+
+	if got := p.input.Value(); got != "amazon linux" {
+		t.Errorf("filter = %q, want %q", got, "amazon linux")
+	}
+	if len(p.order) != 0 {
+		t.Errorf("space marked %v", p.order)
+	}
+	if len(p.matches) == 0 || p.matches[0] != "amazon-linux" {
+		t.Errorf("matches = %v, want amazon-linux first", p.matches)
+	}
+}
+
+func TestPicker_EnterWithoutMatches(t *testing.T) {
+	p, _ := press(newPicker(pickerProducts, "cobol", nil, Options{}), tea.KeyMsg{Type: tea.KeyEnter})
+
+	if p.done {
+		t.Error("enter without matches finished the picker")
+	}
+}
+
+func TestPicker_Cancel(t *testing.T) {
+	for _, key := range []tea.KeyType{tea.KeyEsc, tea.KeyCtrlC} {
+		p, _ := press(newPicker(pickerProducts, "", nil, Options{}), tea.KeyMsg{Type: key})
+		if !p.cancelled || p.done {
+			t.Errorf("%v: cancelled = %v, done = %v", key, p.cancelled, p.done)
+		}
+	}
+}
+
+func TestPicker_Preview(t *testing.T) {
+	var fetched []string
+	fetch := func(product string) ([]api.Cycle, error) {
+		fetched = append(fetched, product)
+		if product == "nodejs" {
+			return nil, errors.New("boom")
+		}
+		return []api.Cycle{{Cycle: "3.13", EOL: api.EOLValue{DateValue: time.Date(2099, 10, 31, 0, 0, 0, 0, time.UTC)}}}, nil
+	}
+
+	p := newPicker(pickerProducts, "python", fetch, Options{})
+	cmd := p.loadPreview()
+	if cmd == nil {
+		t.Fatal("loadPreview() returned no command")
+	}
+	if p.loadPreview() != nil {
+		t.Error("loadPreview() fetched a loading product twice")
+	}
+	if !strings.Contains(p.previewView(), "Loading") {
+		t.Errorf("preview while loading = %q", p.previewView())
+	}
+
+	m, _ := p.Update(cmd())
+	p = m.(picker)
+	if !strings.Contains(p.previewView(), "3.13") {
+		t.Errorf("preview = %q, want cycle 3.13", p.previewView())
+	}
+
+	p, cmd = press(p, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace},
+		tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace},
+		tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyDown})
+	if cur, _ := p.current(); cur != "nodejs" {
+		t.Fatalf("current() = %q, want nodejs", cur)
+	}
+	m, _ = p.Update(cmd())
+	p = m.(picker)
+	if !strings.Contains(p.previewView(), "boom") {
+		t.Errorf("preview = %q, want the fetch error", p.previewView())
+	}
+
+	if !slices.Equal(fetched, []string{"python", "nodejs"}) {
+		t.Errorf("fetched = %v, want each product once", fetched)
+	}
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"io"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/oliverandrich/eol-date/internal/api"
+	"gopkg.in/yaml.v3"
+)
+
+// exportCycle is the per-cycle data of the yaml and toml formats. Date-or-flag
+// values hold a "YYYY-MM-DD" string or a boolean and are omitted when unset.
+type exportCycle struct { //nolint:govet // field order defines the key order
+	Cycle           string     `yaml:"cycle"                     toml:"cycle"`
+	Codename        string     `yaml:"codename,omitempty"        toml:"codename,omitempty"`
+	ReleaseLabel    string     `yaml:"releaseLabel,omitempty"    toml:"releaseLabel,omitempty"`
+	Latest          string     `yaml:"latest"                    toml:"latest"`
+	ReleaseDate     string     `yaml:"releaseDate,omitempty"     toml:"releaseDate,omitempty"`
+	Support         any        `yaml:"support,omitempty"         toml:"support,omitempty"`
+	ExtendedSupport any        `yaml:"extendedSupport,omitempty" toml:"extendedSupport,omitempty"`
+	EOL             any        `yaml:"eol,omitempty"             toml:"eol,omitempty"`
+	Discontinued    any        `yaml:"discontinued,omitempty"    toml:"discontinued,omitempty"`
+	LTS             bool       `yaml:"lts"                       toml:"lts"`
+	IsEOL           bool       `yaml:"isEOL"                     toml:"isEOL"`
+	Status          api.Status `yaml:"status"                    toml:"status"`
+	Link            string     `yaml:"link,omitempty"            toml:"link,omitempty"`
+}
+
+// exportDocument is the top-level object of the yaml and toml formats
+type exportDocument struct {
+	Product string        `yaml:"product" toml:"product"`
+	Cycles  []exportCycle `yaml:"cycles"  toml:"cycles"`
+}
+
+// exportProducts is the top-level object of the yaml and toml formats for several products
+type exportProducts struct {
+	Products []exportDocument `yaml:"products" toml:"products"`
+}
+
+// exportValue returns the upstream form of v: a date string, a boolean or nil
+func exportValue(v api.EOLValue) any {
+	if v.IsBoolean {
+		return v.BoolValue
+	}
+	if v.DateValue.IsZero() {
+		return nil
+	}
+	return v.DateValue.Format("2006-01-02")
+}
+
+// newExportDocument converts cycles, honoring opts.ShowAll
+func newExportDocument(product string, cycles []api.Cycle, opts Options, now time.Time) exportDocument {
+	doc := exportDocument{Product: product, Cycles: make([]exportCycle, 0, len(cycles))}
+	for _, c := range cycles {
+		if !opts.ShowAll && c.EOL.IsEOLAt(now) {
+			continue
+		}
+
+		ec := exportCycle{
+			Cycle:           c.Cycle,
+			Codename:        c.Codename,
+			ReleaseLabel:    c.ReleaseLabel,
+			Latest:          c.Latest,
+			Support:         exportValue(c.Support),
+			ExtendedSupport: exportValue(c.ExtendedSupport),
+			EOL:             exportValue(c.EOL),
+			Discontinued:    exportValue(c.Discontinued),
+			LTS:             c.LTS.IsLTS(),
+			IsEOL:           c.EOL.IsEOLAt(now),
+			Status:          c.StatusAt(now, opts.WarnDays),
+			Link:            c.Link,
+		}
+		if !c.ReleaseDate.IsZero() {
+			ec.ReleaseDate = c.ReleaseDate.Format("2006-01-02")
+		}
+		doc.Cycles = append(doc.Cycles, ec)
+	}
+	return doc
+}
+
+// newExportProducts converts the cycles of several products
+func newExportProducts(products []ProductCycles, opts Options, now time.Time) exportProducts {
+	doc := exportProducts{Products: make([]exportDocument, 0, len(products))}
+	for _, p := range products {
+		doc.Products = append(doc.Products, newExportDocument(p.Product, p.Cycles, opts, now))
+	}
+	return doc
+}
+
+// formatAsYAML writes the cycles as a YAML document
+func formatAsYAML(w io.Writer, product string, cycles []api.Cycle, opts Options, now time.Time) error {
+	return encodeYAML(w, newExportDocument(product, cycles, opts, now))
+}
+
+// formatAsTOML writes the cycles as a TOML document with one [[cycles]] table per cycle
+func formatAsTOML(w io.Writer, product string, cycles []api.Cycle, opts Options, now time.Time) error {
+	return encodeTOML(w, newExportDocument(product, cycles, opts, now))
+}
+
+// encodeYAML writes v as YAML with two-space indentation
+func encodeYAML(w io.Writer, v any) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// encodeTOML writes v as TOML without indenting nested tables
+func encodeTOML(w io.Writer, v any) error {
+	enc := toml.NewEncoder(w)
+	enc.Indent = ""
+	return enc.Encode(v)
+}
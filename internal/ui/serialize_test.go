@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatAsYAML(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := formatAsYAML(&buf, "python", goldenCycles(), Options{ShowAll: true}, now); err != nil {
+		t.Fatalf("formatAsYAML() error = %v", err)
+	}
+	assertGolden(t, "cycles.yaml", buf.Bytes())
+}
+
+func TestFormatAsTOML(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := formatAsTOML(&buf, "python", goldenCycles(), Options{ShowAll: true}, now); err != nil {
+		t.Fatalf("formatAsTOML() error = %v", err)
+	}
+	assertGolden(t, "cycles.toml", buf.Bytes())
+}
+
+func TestValidateFormat(t *testing.T) {
+	for _, f := range Formats {
+		if err := ValidateFormat(f); err != nil {
+			t.Errorf("ValidateFormat(%q) error = %v", f, err)
+		}
+	}
+
+	err := ValidateFormat("xml")
+	if err == nil {
+		t.Fatal("ValidateFormat(\"xml\") returned no error")
+	}
+	if !strings.Contains(err.Error(), "table, markdown, csv, html, json, ndjson, yaml, toml") {
+		t.Errorf("ValidateFormat() error = %q, want list of valid formats", err)
+	}
+}
+
+func TestDisplayCycles_UnknownFormat(t *testing.T) {
+	if err := DisplayCycles("python", goldenCycles(), Options{Format: "xml"}); err == nil {
+		t.Error("DisplayCycles() with unknown format returned no error")
+	}
+}
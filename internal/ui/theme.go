@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme holds the colors of the terminal output
+type Theme struct {
+	Active      lipgloss.TerminalColor
+	Warn        lipgloss.TerminalColor
+	EOL         lipgloss.TerminalColor
+	Dim         lipgloss.TerminalColor
+	Header      lipgloss.TerminalColor
+	TableHeader lipgloss.TerminalColor
+	Selected    lipgloss.TerminalColor
+}
+
+// Themes maps the theme names to their colors
+var Themes = map[string]Theme{
+	"dark": {
+		Active:      lipgloss.Color("42"),  // green
+		Warn:        lipgloss.Color("220"), // yellow
+		EOL:         lipgloss.Color("203"), // red
+		Dim:         lipgloss.Color("240"), // grey
+		Header:      lipgloss.Color("212"),
+		TableHeader: lipgloss.Color("252"),
+		Selected:    lipgloss.Color("170"),
+	},
+	"light": {
+		Active:      lipgloss.Color("28"),  // dark green
+		Warn:        lipgloss.Color("130"), // orange
+		EOL:         lipgloss.Color("160"), // dark red
+		Dim:         lipgloss.Color("244"), // grey
+		Header:      lipgloss.Color("90"),
+		TableHeader: lipgloss.Color("236"),
+		Selected:    lipgloss.Color("127"),
+	},
+	"none": {
+		Active:      lipgloss.NoColor{},
+		Warn:        lipgloss.NoColor{},
+		EOL:         lipgloss.NoColor{},
+		Dim:         lipgloss.NoColor{},
+		Header:      lipgloss.NoColor{},
+		TableHeader: lipgloss.NoColor{},
+		Selected:    lipgloss.NoColor{},
+	},
+}
+
+// ThemeNames lists the available themes, the default first
+var ThemeNames = []string{"dark", "light", "none"}
+
+// theme is the active theme
+var theme = Themes["dark"]
+
+// SetTheme selects the colors of the terminal output by name
+func SetTheme(name string) error {
+	t, ok := Themes[name]
+	if !ok {
+		return fmt.Errorf("unknown theme '%s' (available: %s)", name, strings.Join(ThemeNames, ", "))
+	}
+
+	theme = t
+	headerStyle = headerStyle.Foreground(t.Header)
+	dimStyle = dimStyle.Foreground(t.Dim)
+	tableHeaderStyle = tableHeaderStyle.Foreground(t.TableHeader)
+	pickerCursorStyle = pickerCursorStyle.Foreground(t.Selected)
+	pickerHelpStyle = pickerHelpStyle.Foreground(t.Dim)
+	pickerPreviewBorder = pickerPreviewBorder.BorderForeground(t.Dim)
+	return nil
+}
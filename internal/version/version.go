@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+// Version is a parsed version string such as "v20.11.0" or "jdk-17.0.9+9"
+type Version struct {
+	// Original is the string the version was parsed from
+	Original string
+	// Prerelease holds a suffix like "rc.1" that marks a pre-release
+	Prerelease string
+	// Variant holds any other suffix, e.g. "slim" or "alpine" of an image tag
+	Variant string
+	// Build holds build metadata following a "+"
+	Build string
+	// Parts are the numeric components, e.g. [17 0 9]
+	Parts []int
+}
+
+// prereleaseTags start the suffixes that mark a pre-release
+var prereleaseTags = []string{"alpha", "beta", "rc", "preview", "pre", "dev", "snapshot", "nightly", "canary", "ea", "a", "b"}
+
+// isPrerelease reports whether suffix is a pre-release tag like "rc.1",
+// "beta" or "a1" rather than a variant like "alpine" or "slim-bookworm"
+func isPrerelease(suffix string) bool {
+	for _, tag := range prereleaseTags {
+		rest, ok := strings.CutPrefix(suffix, tag)
+		if ok && (rest == "" || strings.IndexByte("0123456789.-_", rest[0]) >= 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse extracts the numeric core and suffixes from a version string. Any
+// non-numeric prefix like "v", "go" or "jdk-" is skipped. A suffix is a
+// pre-release if it starts with a tag like "rc" or "beta" and a variant
+// otherwise.
+func Parse(s string) (Version, error) {
+	v := Version{Original: s}
+	rest := strings.ToLower(strings.TrimSpace(s))
+
+	start := strings.IndexAny(rest, "0123456789")
+	if start < 0 {
+		return v, fmt.Errorf("no numeric version in %q", s)
+	}
+	rest = rest[start:]
+
+	for {
+		end := 0
+		for end < len(rest) && rest[end] >= '0' && rest[end] <= '9' {
+			end++
+		}
+		n, err := strconv.Atoi(rest[:end])
+		if err != nil {
+			return v, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+		v.Parts = append(v.Parts, n)
+		rest = rest[end:]
+
+		// Continue only if a dot is followed by another number
+		if len(rest) < 2 || rest[0] != '.' || rest[1] < '0' || rest[1] > '9' {
+			break
+		}
+		rest = rest[1:]
+	}
+
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+	}
+	if suffix := strings.TrimLeft(rest, "-._"); isPrerelease(suffix) {
+		v.Prerelease = suffix
+	} else {
+		v.Variant = suffix
+	}
+
+	return v, nil
+}
+
+// String returns the dotted numeric core of the version
+func (v Version) String() string {
+	parts := make([]string, len(v.Parts))
+	for i, p := range v.Parts {
+		parts[i] = strconv.Itoa(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Normalize returns the dotted numeric core of s, or s lowercased without a
+// leading "v" if it contains no number
+func Normalize(s string) string {
+	v, err := Parse(s)
+	if err != nil {
+		return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "v")
+	}
+	return v.String()
+}
+
+// Compare returns -1, 0 or 1 if a is older than, equal to or newer than b.
+// Missing components count as zero; a pre-release is older than its release
+// and variants are ignored.
+func Compare(a, b Version) int {
+	for i := range max(len(a.Parts), len(b.Parts)) {
+		x, y := part(a.Parts, i), part(b.Parts, i)
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case a.Prerelease == b.Prerelease:
+		return 0
+	case a.Prerelease == "":
+		return 1
+	case b.Prerelease == "":
+		return -1
+	default:
+		return strings.Compare(a.Prerelease, b.Prerelease)
+	}
+}
+
+// part returns the i-th component or zero if there is none
+func part(parts []int, i int) int {
+	if i < len(parts) {
+		return parts[i]
+	}
+	return 0
+}
+
+// startsWithDigit reports whether s begins with a digit
+func startsWithDigit(s string) bool {
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// hasPrefix reports whether prefix's components start v's components
+func hasPrefix(v, prefix []int) bool {
+	if len(prefix) > len(v) {
+		return false
+	}
+	for i, p := range prefix {
+		if v[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+// Match returns the release cycle s belongs to. Cycle names are matched
+// literally first, then as numeric prefixes of the version, preferring the
+// longest one ("3.11.4" matches "3.11" rather than "3"). A short version
+// like "8" also matches a cycle "8.0" whose extra components are zero.
+func Match(cycles []api.Cycle, s string) (api.Cycle, bool) {
+	literal := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "v")
+	for _, c := range cycles {
+		if strings.ToLower(c.Cycle) == literal {
+			return c, true
+		}
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return api.Cycle{}, false
+	}
+
+	var (
+		best      api.Cycle
+		bestParts int
+		found     bool
+	)
+	for _, c := range cycles {
+		cv, err := Parse(c.Cycle)
+		// Cycles like "11-24h2-e", "focal" or "jdk8" cannot be matched numerically
+		if err != nil || cv.Prerelease != "" || cv.Variant != "" || cv.Build != "" || !startsWithDigit(c.Cycle) {
+			continue
+		}
+
+		matched := hasPrefix(v.Parts, cv.Parts)
+		if !matched && len(cv.Parts) > len(v.Parts) && hasPrefix(cv.Parts, v.Parts) {
+			// "8" matches "8.0", but never "8.1"
+			matched = true
+			for _, p := range cv.Parts[len(v.Parts):] {
+				if p != 0 {
+					matched = false
+					break
+				}
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		// Prefer the most specific cycle that does not exceed the version
+		n := min(len(cv.Parts), len(v.Parts))
+		if !found || n > bestParts {
+			best, bestParts, found = c, n, true
+		}
+	}
+
+	return best, found
+}
+
+// Behind returns how many releases s lags behind latest, counted at the first
+// component in which they differ: "3.11.4" is 5 behind "3.11.9" and "20.11.0"
+// is 8 behind "20.19.5". A pre-release of latest counts as one release behind;
+// a variant like "3.11.9-slim" counts as the release itself.
+// ok is false if either version cannot be parsed or s is newer than latest.
+func Behind(s, latest string) (n int, ok bool) {
+	v, err := Parse(s)
+	if err != nil {
+		return 0, false
+	}
+	l, err := Parse(latest)
+	if err != nil {
+		return 0, false
+	}
+
+	if Compare(v, l) > 0 {
+		return 0, false
+	}
+
+	for i := range max(len(v.Parts), len(l.Parts)) {
+		if d := part(l.Parts, i) - part(v.Parts, i); d != 0 {
+			return d, true
+		}
+	}
+
+	if v.Prerelease != "" && l.Prerelease == "" {
+		return 1, true
+	}
+	return 0, true
+}
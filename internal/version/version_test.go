@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: EUPL-1.2
+// Copyright (c) 2025 Oliver Andrich
+
+package version
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/oliverandrich/eol-date/internal/api"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct { //nolint:govet // field alignment irrelevant for test struct
+		input      string
+		wantParts  []int
+		wantPre    string
+		wantVar    string
+		wantBuild  string
+		wantString string
+		wantErr    bool
+	}{
+		{input: "1.22.3", wantParts: []int{1, 22, 3}, wantString: "1.22.3"},
+		{input: "v20.11.0", wantParts: []int{20, 11, 0}, wantString: "20.11.0"},
+		{input: "go1.22.3", wantParts: []int{1, 22, 3}, wantString: "1.22.3"},
+		{input: "8.0.100-rc.1", wantParts: []int{8, 0, 100}, wantPre: "rc.1", wantString: "8.0.100"},
+		{input: "2022.3", wantParts: []int{2022, 3}, wantString: "2022.3"},
+		{input: "jdk-17.0.9+9", wantParts: []int{17, 0, 9}, wantBuild: "9", wantString: "17.0.9"},
+		{input: "8u392", wantParts: []int{8}, wantVar: "u392", wantString: "8"},
+		{input: "22.04", wantParts: []int{22, 4}, wantString: "22.4"},
+		{input: "3.12-alpine", wantParts: []int{3, 12}, wantVar: "alpine", wantString: "3.12"},
+		{input: "3.12.7-slim", wantParts: []int{3, 12, 7}, wantVar: "slim", wantString: "3.12.7"},
+		{input: "20-alpine", wantParts: []int{20}, wantVar: "alpine", wantString: "20"},
+		{input: "3.12.7-slim-bookworm", wantParts: []int{3, 12, 7}, wantVar: "slim-bookworm", wantString: "3.12.7"},
+		{input: "17-jdk-bullseye", wantParts: []int{17}, wantVar: "jdk-bullseye", wantString: "17"},
+		{input: "3.13.0a1", wantParts: []int{3, 13, 0}, wantPre: "a1", wantString: "3.13.0"},
+		{input: "3.13.0b2", wantParts: []int{3, 13, 0}, wantPre: "b2", wantString: "3.13.0"},
+		{input: "1.0.0-beta", wantParts: []int{1, 0, 0}, wantPre: "beta", wantString: "1.0.0"},
+		{input: "9.0.100-preview.7", wantParts: []int{9, 0, 100}, wantPre: "preview.7", wantString: "9.0.100"},
+		{input: " 3. ", wantParts: []int{3}, wantPre: "", wantString: "3"},
+		{input: "latest", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Parts, tt.wantParts) {
+				t.Errorf("Parse(%q).Parts = %v, want %v", tt.input, got.Parts, tt.wantParts)
+			}
+			if got.Prerelease != tt.wantPre {
+				t.Errorf("Parse(%q).Prerelease = %q, want %q", tt.input, got.Prerelease, tt.wantPre)
+			}
+			if got.Variant != tt.wantVar {
+				t.Errorf("Parse(%q).Variant = %q, want %q", tt.input, got.Variant, tt.wantVar)
+			}
+			if got.Build != tt.wantBuild {
+				t.Errorf("Parse(%q).Build = %q, want %q", tt.input, got.Build, tt.wantBuild)
+			}
+			if got.String() != tt.wantString {
+				t.Errorf("Parse(%q).String() = %q, want %q", tt.input, got.String(), tt.wantString)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"v1.22.3":     "1.22.3",
+		"jdk-17.0.9":  "17.0.9",
+		"Focal":       "focal",
+		"vNext":       "next",
+		"3.11.4-slim": "3.11.4",
+	}
+	for input, want := range tests {
+		if got := Normalize(input); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.22.3", "1.22.3", 0},
+		{"1.22.3", "1.22.10", -1},
+		{"1.23", "1.22.10", 1},
+		{"8.0", "8", 0},
+		{"8.0.100-rc.1", "8.0.100", -1},
+		{"8.0.100", "8.0.100-rc.1", 1},
+		{"8.0.100-rc.1", "8.0.100-rc.2", -1},
+		{"3.12.7-slim", "3.12.7", 0},
+		{"3.12.7-alpine", "3.12.8", -1},
+	}
+
+	for _, tt := range tests {
+		a, _ := Parse(tt.a)
+		b, _ := Parse(tt.b)
+		if got := Compare(a, b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func cycles(names ...string) []api.Cycle {
+	c := make([]api.Cycle, len(names))
+	for i, n := range names {
+		c[i] = api.Cycle{Cycle: n}
+	}
+	return c
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		cycles    []api.Cycle
+		version   string
+		wantCycle string
+		wantFound bool
+	}{
+		{"go", cycles("1.23", "1.22", "1.21"), "1.22.3", "1.22", true},
+		{"go toolchain prefix", cycles("1.23", "1.22"), "go1.23.1", "1.23", true},
+		{"nodejs", cycles("22", "21", "20", "18"), "v20.11.0", "20", true},
+		{"dotnet pre-release", cycles("9.0", "8.0", "7.0"), "8.0.100-rc.1", "8.0", true},
+		{"dotnet short", cycles("9.0", "8.0"), "8", "8.0", true},
+		{"short never matches minor", cycles("8.1"), "8", "", false},
+		{"unity", cycles("2023.2", "2022.3", "2021.3"), "2022.3", "2022.3", true},
+		{"unity patch", cycles("2022.3", "2021.3"), "2022.3.14f1", "2022.3", true},
+		{"java", cycles("21", "17", "11", "8"), "jdk-17.0.9+9", "17", true},
+		{"java 8 update", cycles("21", "17", "8"), "8u392", "8", true},
+		{"python longest prefix", cycles("3.13", "3.12", "3.1", "3"), "3.12.7", "3.12", true},
+		{"python 3.1 vs 3.10", cycles("3.10", "3.1"), "3.10.2", "3.10", true},
+		{"ubuntu", cycles("24.04", "22.04", "20.04"), "22.04.3", "22.04", true},
+		{"literal cycle", cycles("11-24h2-e", "11-23h2-w"), "11-24H2-E", "11-24h2-e", true},
+		{"image variant", cycles("3.13", "3.12"), "3.12.7-slim", "3.12", true},
+		{"non-numeric cycles skipped", cycles("focal", "jammy"), "22.04", "", false},
+		{"no match", cycles("3.13", "3.12"), "2.7.18", "", false},
+		{"garbage", cycles("3.13"), "latest", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := Match(tt.cycles, tt.version)
+			if found != tt.wantFound {
+				t.Fatalf("Match(%q) found = %v, want %v", tt.version, found, tt.wantFound)
+			}
+			if got.Cycle != tt.wantCycle {
+				t.Errorf("Match(%q) = %q, want %q", tt.version, got.Cycle, tt.wantCycle)
+			}
+		})
+	}
+}
+
+func TestBehind(t *testing.T) {
+	tests := []struct {
+		version string
+		latest  string
+		want    int
+		wantOK  bool
+	}{
+		{"3.11.4", "3.11.9", 5, true},
+		{"3.11.9", "3.11.9", 0, true},
+		{"v20.11.0", "20.19.5", 8, true},
+		{"1.22.3", "1.22.10", 7, true},
+		{"jdk-17.0.9+9", "17.0.16", 7, true},
+		{"8.0.100-rc.1", "8.0.100", 1, true},
+		{"3.13", "3.13.11", 11, true},
+		{"3.12.7-slim", "3.12.7", 0, true},
+		{"3.12.7-slim-bookworm", "3.12.8", 1, true},
+		{"20-alpine", "20", 0, true},
+		{"20.19.5-alpine", "20.19.5", 0, true},
+		{"3.11.10", "3.11.9", 0, false},
+		{"latest", "3.11.9", 0, false},
+		{"3.11.9", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+"→"+tt.latest, func(t *testing.T) {
+			got, ok := Behind(tt.version, tt.latest)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Behind(%q, %q) = %d, %v; want %d, %v", tt.version, tt.latest, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}